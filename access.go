@@ -0,0 +1,341 @@
+package fsgraph
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+type accessRule struct {
+	identity string // Or * for anyone.
+	write    bool   // Write access also allows reading.
+	pattern  string
+	prefix   string // The dir of the pattern before any wildcards.
+}
+
+// AccessRules allow identities to access paths, anything not allowed is denied.
+type AccessRules struct {
+	rules []accessRule
+}
+
+// LoadAccessRules loads a file of rules, one per line, of: identity access pattern
+// where identity is an Identity name or * for anyone, including unauthenticated requests,
+// access is read or write (which includes read),
+// and pattern is a path glob, where ** matches zero or more path elements, such as /logs/**
+// The dirs leading to an allowed path can also be listed, showing only what leads to allowed paths.
+func LoadAccessRules(filename string) (*AccessRules, error) {
+	ar := &AccessRules{}
+	err := readConfigLines(filename, func(line string) error {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return errors.New("Invalid rule, expected identity access pattern: " + line)
+		}
+		rule := accessRule{identity: fields[0], pattern: fields[2]}
+		switch fields[1] {
+		case "read":
+		case "write":
+			rule.write = true
+		default:
+			return errors.New("Invalid access, expected read or write: " + fields[1])
+		}
+		if !strings.HasPrefix(rule.pattern, "/") {
+			return errors.New("Invalid pattern, expected an absolute path: " + rule.pattern)
+		}
+		if _, err := path.Match(rule.pattern, ""); err != nil {
+			return errors.Wrap(err, "Invalid pattern")
+		}
+		rule.prefix = "/"
+		for _, elem := range strings.Split(rule.pattern, "/") {
+			if strings.ContainsAny(elem, `*?[\`) {
+				break
+			}
+			rule.prefix = path.Join(rule.prefix, elem)
+		}
+		ar.rules = append(ar.rules, rule)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to load access rules")
+	}
+	return ar, nil
+}
+
+// Returns the rules which apply to the identity, nil for none.
+func (ar *AccessRules) forIdentity(id *Identity) []accessRule {
+	var rules []accessRule
+	for _, rule := range ar.rules {
+		if rule.identity == "*" || (id != nil && rule.identity == id.Name) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// Returns the FS as seen by the identity in ctx, if there are access rules.
+func (fs FS) forContext(ctx context.Context) FS {
+	if fs.Access == nil {
+		return fs
+	}
+	fs.Fs = &accessFs{Fs: fs.Fs, rules: fs.Access.forIdentity(GetIdentity(ctx))}
+	fs.Access = nil
+	return fs
+}
+
+// accessFs is an afero.Fs which only allows what the rules allow.
+// Denied files are omitted from dir listings.
+type accessFs struct {
+	afero.Fs
+	rules []accessRule
+}
+
+var _ afero.Lstater = &accessFs{}
+var _ afero.Linker = &accessFs{}
+var _ afero.LinkReader = &accessFs{}
+var _ HardLinker = &accessFs{}
+var _ OsPather = &accessFs{}
+
+func accessPath(name string) string {
+	return cleanPath(filepath.ToSlash(name))
+}
+
+// Reports whether a rule allows the access to fpath.
+func (fs *accessFs) allowed(fpath string, write bool) bool {
+	for _, rule := range fs.rules {
+		if (rule.write || !write) && matchGlob(rule.pattern, fpath) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports whether the file can be seen, which is if it can be read or leads to a rule's path.
+func (fs *accessFs) visible(fpath string) bool {
+	if fs.allowed(fpath, false) {
+		return true
+	}
+	for _, rule := range fs.rules {
+		if isPathWithin(rule.prefix, fpath) {
+			return true
+		}
+	}
+	return false
+}
+
+func (fs *accessFs) permits(fpath string, write bool) bool {
+	return write && fs.allowed(fpath, true) || !write && fs.visible(fpath)
+}
+
+// Checks the access to name, both as named and with its symlinks resolved,
+// through the last element if follow, so symlinks can't lead outside the rules.
+// Returns the resolved path.
+func (fs *accessFs) check(op, name string, write, follow bool) (string, error) {
+	fpath := accessPath(name)
+	if fs.permits(fpath, write) {
+		resolved, err := FS{Fs: fs.Fs}.resolveSymlinks(fpath, follow)
+		if err == nil && fs.permits(resolved, write) {
+			return resolved, nil
+		}
+	}
+	return "", &os.PathError{Op: op, Path: fpath, Err: os.ErrPermission}
+}
+
+func (fs *accessFs) Name() string {
+	return "accessFs"
+}
+
+func (fs *accessFs) Create(name string) (afero.File, error) {
+	return fs.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+func (fs *accessFs) Mkdir(name string, perm os.FileMode) error {
+	if _, err := fs.check("mkdir", name, true, true); err != nil {
+		return err
+	}
+	return fs.Fs.Mkdir(name, perm)
+}
+
+func (fs *accessFs) MkdirAll(name string, perm os.FileMode) error {
+	if _, err := fs.check("mkdir", name, true, true); err != nil {
+		return err
+	}
+	return fs.Fs.MkdirAll(name, perm)
+}
+
+func (fs *accessFs) Open(name string) (afero.File, error) {
+	return fs.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (fs *accessFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	write := flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0
+	resolved, err := fs.check("open", name, write, true)
+	if err != nil {
+		return nil, err
+	}
+	fpath := accessPath(name)
+	f, err := fs.Fs.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	if !fs.allowed(fpath, write) || !fs.allowed(resolved, write) {
+		// Only visible, as a dir leading to an allowed path.
+		fi, err := f.Stat()
+		if err != nil || !fi.IsDir() {
+			f.Close()
+			return nil, &os.PathError{Op: "open", Path: fpath, Err: os.ErrPermission}
+		}
+	}
+	// Its entries are listed by where they really are.
+	return &accessFile{File: f, fs: fs, path: resolved}, nil
+}
+
+func (fs *accessFs) Remove(name string) error {
+	if _, err := fs.check("remove", name, true, false); err != nil {
+		return err
+	}
+	return fs.Fs.Remove(name)
+}
+
+func (fs *accessFs) RemoveAll(name string) error {
+	if _, err := fs.check("remove", name, true, false); err != nil {
+		return err
+	}
+	return fs.Fs.RemoveAll(name)
+}
+
+func (fs *accessFs) Rename(oldname, newname string) error {
+	if _, err := fs.check("rename", oldname, true, false); err != nil {
+		return err
+	}
+	if _, err := fs.check("rename", newname, true, false); err != nil {
+		return err
+	}
+	return fs.Fs.Rename(oldname, newname)
+}
+
+func (fs *accessFs) Stat(name string) (os.FileInfo, error) {
+	if _, err := fs.check("stat", name, false, true); err != nil {
+		return nil, err
+	}
+	return fs.Fs.Stat(name)
+}
+
+func (fs *accessFs) LstatIfPossible(name string) (os.FileInfo, bool, error) {
+	if _, err := fs.check("lstat", name, false, false); err != nil {
+		return nil, false, err
+	}
+	if lstater, ok := fs.Fs.(afero.Lstater); ok {
+		return lstater.LstatIfPossible(name)
+	}
+	fi, err := fs.Fs.Stat(name)
+	return fi, false, err
+}
+
+func (fs *accessFs) Chmod(name string, mode os.FileMode) error {
+	if _, err := fs.check("chmod", name, true, true); err != nil {
+		return err
+	}
+	return fs.Fs.Chmod(name, mode)
+}
+
+func (fs *accessFs) Chown(name string, uid, gid int) error {
+	if _, err := fs.check("chown", name, true, true); err != nil {
+		return err
+	}
+	return fs.Fs.Chown(name, uid, gid)
+}
+
+func (fs *accessFs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	if _, err := fs.check("chtimes", name, true, true); err != nil {
+		return err
+	}
+	return fs.Fs.Chtimes(name, atime, mtime)
+}
+
+func (fs *accessFs) SymlinkIfPossible(oldname, newname string) error {
+	newpath, err := fs.check("symlink", newname, true, false)
+	if err != nil {
+		return err
+	}
+	// The target must be readable, from where the link really is.
+	target := filepath.ToSlash(oldname)
+	resolved, err := FS{Fs: fs.Fs}.resolveTarget(path.Dir(newpath), target)
+	if !path.IsAbs(target) {
+		target = path.Join(path.Dir(newpath), target)
+	}
+	if err != nil || !fs.allowed(accessPath(target), false) || !fs.allowed(resolved, false) {
+		return &os.LinkError{Op: "symlink", Old: oldname, New: newname, Err: os.ErrPermission}
+	}
+	return FS{Fs: fs.Fs}.symlink(oldname, newname)
+}
+
+func (fs *accessFs) ReadlinkIfPossible(name string) (string, error) {
+	if _, err := fs.check("readlink", name, false, false); err != nil {
+		return "", err
+	}
+	if lr, ok := fs.Fs.(afero.LinkReader); ok {
+		return lr.ReadlinkIfPossible(name)
+	}
+	return "", &os.PathError{Op: "readlink", Path: name, Err: afero.ErrNoReadlink}
+}
+
+func (fs *accessFs) LinkIfPossible(oldname, newname string) error {
+	// Write access to both, as the link shares the file.
+	if _, err := fs.check("link", oldname, true, false); err != nil {
+		return err
+	}
+	if _, err := fs.check("link", newname, true, false); err != nil {
+		return err
+	}
+	return FS{Fs: fs.Fs}.link(oldname, newname)
+}
+
+func (fs *accessFs) OsPath(name string) (string, error) {
+	if ospath, ok := (FS{Fs: fs.Fs}).osPath(name); ok {
+		return ospath, nil
+	}
+	return "", errNoOSWatch
+}
+
+// accessFile omits the denied files from its dir listings.
+type accessFile struct {
+	afero.File
+	fs   *accessFs
+	path string
+}
+
+func (f *accessFile) Readdir(count int) ([]os.FileInfo, error) {
+	for {
+		list, err := f.File.Readdir(count)
+		visible := list[:0]
+		for _, fi := range list {
+			if f.fs.visible(path.Join(f.path, fi.Name())) {
+				visible = append(visible, fi)
+			}
+		}
+		// If a page was all denied, keep going, as an empty page means the end.
+		if len(visible) > 0 || err != nil || count <= 0 {
+			return visible, err
+		}
+	}
+}
+
+func (f *accessFile) Readdirnames(count int) ([]string, error) {
+	for {
+		names, err := f.File.Readdirnames(count)
+		visible := names[:0]
+		for _, name := range names {
+			if f.fs.visible(path.Join(f.path, name)) {
+				visible = append(visible, name)
+			}
+		}
+		if len(visible) > 0 || err != nil || count <= 0 {
+			return visible, err
+		}
+	}
+}
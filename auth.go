@@ -0,0 +1,288 @@
+package fsgraph
+
+import (
+	"bufio"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Identity is who made a request, as determined by an Authenticator.
+type Identity struct {
+	Name string
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a context with the identity of the request.
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// GetIdentity returns the identity of the request, or nil if none.
+func GetIdentity(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+// Authenticator determines who made a request.
+// Authenticate returns nil and no error if the request has no valid credentials for it.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// AuthenticatorFunc is an Authenticator function.
+type AuthenticatorFunc func(r *http.Request) (*Identity, error)
+
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (*Identity, error) {
+	return f(r)
+}
+
+// An Authenticator which can tell the client how to authenticate, for WWW-Authenticate.
+type challenger interface {
+	challenge() string
+}
+
+// AuthHandler wraps a handler to require an identity from one of the authenticators,
+// which is put in the request context, see GetIdentity.
+// Requests without one get 401 Unauthorized.
+func AuthHandler(next http.Handler, auths ...Authenticator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, auth := range auths {
+			id, err := auth.Authenticate(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if id != nil {
+				next.ServeHTTP(w, r.WithContext(WithIdentity(r.Context(), id)))
+				return
+			}
+		}
+		for _, auth := range auths {
+			if c, ok := auth.(challenger); ok {
+				w.Header().Add("WWW-Authenticate", c.challenge())
+			}
+		}
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	})
+}
+
+// Calls fn for each line of the file, without blank lines and # comments.
+func readConfigLines(filename string, fn func(line string) error) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		err := fn(line)
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+type bearerToken struct {
+	token string
+	name  string
+}
+
+// BearerTokens authenticates requests with a static bearer token,
+// from the Authorization header or the access_token query parameter,
+// such as for websockets and download links.
+type BearerTokens struct {
+	tokens []bearerToken
+}
+
+// LoadBearerTokens loads a file with a token per line, optionally followed by
+// whitespace and the identity name, otherwise the name is token and its position, such as token1
+func LoadBearerTokens(filename string) (*BearerTokens, error) {
+	bt := &BearerTokens{}
+	err := readConfigLines(filename, func(line string) error {
+		fields := strings.Fields(line)
+		name := "token" + strconv.Itoa(len(bt.tokens)+1)
+		if len(fields) > 1 {
+			name = fields[1]
+		}
+		bt.tokens = append(bt.tokens, bearerToken{token: fields[0], name: name})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to load bearer tokens")
+	}
+	return bt, nil
+}
+
+func (bt *BearerTokens) Authenticate(r *http.Request) (*Identity, error) {
+	token := r.URL.Query().Get("access_token")
+	if auth := r.Header.Get("Authorization"); len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		token = strings.TrimSpace(auth[7:])
+	}
+	if token == "" {
+		return nil, nil
+	}
+	var found *bearerToken
+	for i := range bt.tokens {
+		// Checks them all in constant time, to not leak what matched.
+		if subtle.ConstantTimeCompare([]byte(token), []byte(bt.tokens[i].token)) == 1 {
+			found = &bt.tokens[i]
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	return &Identity{Name: found.name}, nil
+}
+
+func (bt *BearerTokens) challenge() string {
+	return `Bearer realm="fsgraph"`
+}
+
+// Htpasswd authenticates requests with HTTP basic auth against an htpasswd file.
+type Htpasswd struct {
+	users map[string]string // The password hashes by user name.
+}
+
+// LoadHtpasswd loads an htpasswd file, of user:hash lines.
+// The hashes can be bcrypt (htpasswd -B), apr1 (htpasswd -m) or SHA-1 (htpasswd -s).
+func LoadHtpasswd(filename string) (*Htpasswd, error) {
+	ht := &Htpasswd{users: make(map[string]string)}
+	err := readConfigLines(filename, func(line string) error {
+		i := strings.IndexByte(line, ':')
+		if i <= 0 {
+			return errors.New("Invalid line: " + line)
+		}
+		user, hash := line[:i], line[i+1:]
+		if !isBcrypt(hash) && !strings.HasPrefix(hash, "$apr1$") && !strings.HasPrefix(hash, "{SHA}") {
+			return errors.New("Unsupported hash for user " + user)
+		}
+		ht.users[user] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to load htpasswd")
+	}
+	return ht, nil
+}
+
+func (ht *Htpasswd) Authenticate(r *http.Request) (*Identity, error) {
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, nil
+	}
+	hash, ok := ht.users[user]
+	if !ok {
+		return nil, nil
+	}
+	if isBcrypt(hash) {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+			return nil, nil
+		}
+		return &Identity{Name: user}, nil
+	}
+	var check string
+	if strings.HasPrefix(hash, "{SHA}") {
+		sum := sha1.Sum([]byte(password))
+		check = "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+	} else {
+		salt := strings.TrimPrefix(hash, "$apr1$")
+		if i := strings.IndexByte(salt, '$'); i >= 0 {
+			salt = salt[:i]
+		}
+		check = apr1(password, salt)
+	}
+	if subtle.ConstantTimeCompare([]byte(check), []byte(hash)) != 1 {
+		return nil, nil
+	}
+	return &Identity{Name: user}, nil
+}
+
+// Reports whether the hash is bcrypt, such as $2y$ from htpasswd -B.
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func (ht *Htpasswd) challenge() string {
+	return `Basic realm="fsgraph"`
+}
+
+// Returns the Apache MD5 crypt of password, as $apr1$salt$hash
+func apr1(password, salt string) string {
+	const magic = "$apr1$"
+	pw := []byte(password)
+	d := md5.New()
+	d.Write(pw)
+	d.Write([]byte(magic))
+	d.Write([]byte(salt))
+	d2 := md5.New()
+	d2.Write(pw)
+	d2.Write([]byte(salt))
+	d2.Write(pw)
+	mixin := d2.Sum(nil)
+	for i := len(pw); i > 0; i -= 16 {
+		n := i
+		if n > 16 {
+			n = 16
+		}
+		d.Write(mixin[:n])
+	}
+	for i := len(pw); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			d.Write([]byte{0})
+		} else {
+			d.Write(pw[:1])
+		}
+	}
+	final := d.Sum(nil)
+	for i := 0; i < 1000; i++ {
+		d := md5.New()
+		if i&1 != 0 {
+			d.Write(pw)
+		} else {
+			d.Write(final)
+		}
+		if i%3 != 0 {
+			d.Write([]byte(salt))
+		}
+		if i%7 != 0 {
+			d.Write(pw)
+		}
+		if i&1 != 0 {
+			d.Write(final)
+		} else {
+			d.Write(pw)
+		}
+		final = d.Sum(nil)
+	}
+
+	const itoa64 = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	sb := &strings.Builder{}
+	sb.WriteString(magic + salt + "$")
+	to64 := func(v uint32, n int) {
+		for ; n > 0; n-- {
+			sb.WriteByte(itoa64[v&0x3f])
+			v >>= 6
+		}
+	}
+	for _, x := range [][3]int{{0, 6, 12}, {1, 7, 13}, {2, 8, 14}, {3, 9, 15}, {4, 10, 5}} {
+		to64(uint32(final[x[0]])<<16|uint32(final[x[1]])<<8|uint32(final[x[2]]), 4)
+	}
+	to64(uint32(final[11]), 2)
+	return sb.String()
+}
@@ -33,6 +33,12 @@ func run() error {
 	flag.BoolVar(&protected, "protected", protected, "Writes go to a temporary location")
 	scopestr := ""
 	flag.StringVar(&scopestr, "scope", scopestr, "Set the file ID scope, before hashing (defaults to hostname:root)")
+	tokensfile := ""
+	flag.StringVar(&tokensfile, "tokens", tokensfile, "File of bearer tokens to allow, one per line, optionally followed by a name")
+	htpasswdfile := ""
+	flag.StringVar(&htpasswdfile, "htpasswd", htpasswdfile, "htpasswd file of users to allow with basic auth")
+	accessfile := ""
+	flag.StringVar(&accessfile, "access", accessfile, "File of access rules, lines of: identity read|write pattern")
 	flag.Parse()
 	if address == "" {
 		flag.Usage()
@@ -43,7 +49,7 @@ func run() error {
 		return errors.New("root invalid")
 	}
 
-	rootfs := afero.NewBasePathFs(afero.NewOsFs(), fsrootdir)
+	var rootfs afero.Fs = fsgraph.NewOsBasePathFs(fsrootdir)
 	log.Printf("FS root: %s", fsrootdir)
 
 	if scopestr == "" {
@@ -72,15 +78,50 @@ func run() error {
 			}
 		}()
 		log.Printf("protected: temporary overlay dir: %v", tempdir)
-		rootfs = afero.NewCopyOnWriteFs(rootfs, afero.NewBasePathFs(afero.NewOsFs(), tempdir))
+		rootfs = fsgraph.NewCopyOnWriteFs(rootfs, fsgraph.NewOsBasePathFs(tempdir))
 	}
 
-	http.Handle("/", handler.Playground("GraphQL playground", "/query"))
-	http.Handle("/query",
+	var auths []fsgraph.Authenticator
+	if tokensfile != "" {
+		tokens, err := fsgraph.LoadBearerTokens(tokensfile)
+		if err != nil {
+			return err
+		}
+		auths = append(auths, tokens)
+	}
+	if htpasswdfile != "" {
+		htpasswd, err := fsgraph.LoadHtpasswd(htpasswdfile)
+		if err != nil {
+			return err
+		}
+		auths = append(auths, htpasswd)
+	}
+	handle := func(pattern string, h http.Handler) {
+		if len(auths) > 0 {
+			h = fsgraph.AuthHandler(h, auths...)
+		}
+		http.Handle(pattern, h)
+	}
+	if len(auths) == 0 {
+		log.Printf("authentication: none, anyone who can connect has access")
+	}
+
+	fs := fsgraph.FS{Fs: rootfs, Scope: scope, RawURL: "/raw"}
+	if accessfile != "" {
+		access, err := fsgraph.LoadAccessRules(accessfile)
+		if err != nil {
+			return err
+		}
+		fs.Access = access
+		log.Printf("access rules: %s", accessfile)
+	}
+	handle("/", handler.Playground("GraphQL playground", "/query"))
+	handle("/raw/", http.StripPrefix("/raw", fsgraph.RawHandler(fs)))
+	handle("/query", fsgraph.UploadHandler(
 		handler.GraphQL(
 			fsgraph.NewExecutableSchema(fsgraph.Config{
 				Resolvers: &fsgraph.Resolver{
-					RootFS: fsgraph.FS{Fs: rootfs, Scope: scope},
+					RootFS: fs,
 				},
 			}),
 			handler.ErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
@@ -96,7 +137,7 @@ func run() error {
 				return gqlerr
 			}),
 		),
-	)
+	))
 
 	server := &http.Server{Addr: address, Handler: nil}
 
@@ -0,0 +1,318 @@
+package fsgraph
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+type CopyResult struct {
+	S       string  `json:"s"`
+	Warning *string `json:"warning"`
+	Files   int     `json:"files"`
+	Bytes   Int64   `json:"bytes"`
+	path    string
+}
+
+func (CopyResult) IsResult() {}
+
+// Returns the cleaned path, always starting with a slash.
+func cleanPath(fpath string) string {
+	return path.Clean("/" + fpath)
+}
+
+// Reports whether fpath is dir or is nested in it, both must be clean.
+func isPathWithin(fpath, dir string) bool {
+	return fpath == dir || dir == "/" || strings.HasPrefix(fpath, dir+"/")
+}
+
+type copier struct {
+	ctx           context.Context
+	fs            FS
+	overwrite     bool
+	preserveMode  bool
+	preserveTimes bool
+	files         int
+	bytes         int64
+	warnings      []string
+}
+
+func (c *copier) warn(msg string) {
+	c.warnings = append(c.warnings, msg)
+}
+
+// Returns the warnings joined together, or nil if none.
+func (c *copier) warning() *string {
+	if len(c.warnings) == 0 {
+		return nil
+	}
+	warning := strings.Join(c.warnings, "; ")
+	return &warning
+}
+
+func (c *copier) copyRegular(src, dst string, fi os.FileInfo) error {
+	in, err := c.fs.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !c.overwrite {
+		flags |= os.O_EXCL
+	}
+	perm := os.FileMode(0666)
+	if c.preserveMode {
+		perm = fi.Mode().Perm()
+	}
+	out, err := c.fs.OpenFile(dst, flags, perm)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(out, in)
+	c.bytes += n
+	if err != nil {
+		out.Close()
+		return err
+	}
+	err = out.Close()
+	if err != nil {
+		return err
+	}
+	c.files++
+	if c.preserveMode {
+		err = c.fs.Chmod(dst, perm)
+		if err != nil {
+			return err
+		}
+	}
+	if c.preserveTimes {
+		err = c.fs.Chtimes(dst, fi.ModTime(), fi.ModTime())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *copier) copySymlink(src, dst string) error {
+	lr, ok := c.fs.Fs.(afero.LinkReader)
+	_, ok2 := c.fs.Fs.(afero.Linker)
+	if !ok || !ok2 {
+		c.warn("symlink not copied: " + src)
+		return nil
+	}
+	target, err := lr.ReadlinkIfPossible(src)
+	if err != nil {
+		return err
+	}
+	if c.overwrite {
+		if fi, err := c.fs.Lstat(dst); err == nil && !fi.IsDir() {
+			err = c.fs.Remove(dst)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = c.fs.symlink(target, dst)
+	if err != nil {
+		return err
+	}
+	c.files++
+	return nil
+}
+
+func (c *copier) mkdir(dst string, fi os.FileInfo) error {
+	perm := os.FileMode(0777)
+	if c.preserveMode {
+		perm = fi.Mode().Perm()
+	}
+	err := c.fs.Mkdir(dst, perm)
+	if err != nil {
+		if !c.overwrite || !os.IsExist(err) {
+			return err
+		}
+		dfi, err := c.fs.Stat(dst)
+		if err != nil {
+			return err
+		}
+		if !dfi.IsDir() {
+			return errors.New("Cannot overwrite non-directory with directory: " + dst)
+		}
+	}
+	if c.preserveMode {
+		return c.fs.Chmod(dst, perm)
+	}
+	return nil
+}
+
+// Copies the file or dir tree at src to dst, which are both clean paths.
+func (c *copier) copy(src, dst string, recursive bool) error {
+	if isPathWithin(dst, src) {
+		return errors.New("Cannot copy a file into itself")
+	}
+	fi, err := c.fs.Lstat(src)
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() && !recursive {
+		return errors.New("Cannot copy a directory without recursive")
+	}
+
+	type dirTimes struct {
+		dst string
+		fi  os.FileInfo
+	}
+	var dirs []dirTimes
+	err = afero.Walk(c.fs.Fs, src, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := c.ctx.Err(); err != nil {
+			return err
+		}
+		fpath = filepath.ToSlash(fpath)
+		target := path.Join(dst, strings.TrimPrefix(fpath, src))
+		switch fi.Mode() & os.ModeType {
+		case 0:
+			return c.copyRegular(fpath, target, fi)
+		case os.ModeDir:
+			dirs = append(dirs, dirTimes{target, fi})
+			return c.mkdir(target, fi)
+		case os.ModeSymlink:
+			return c.copySymlink(fpath, target)
+		default:
+			c.warn("special file not copied: " + fpath)
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if c.preserveTimes {
+		// In reverse, after the contents are done changing.
+		for i := len(dirs) - 1; i >= 0; i-- {
+			err := c.fs.Chtimes(dirs[i].dst, dirs[i].fi.ModTime(), dirs[i].fi.ModTime())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Reports whether the rename error means the Fs can't rename it,
+// such as across devices or layers, rather than that the rename is invalid.
+func (fs FS) isRenameUnsupported(err error) bool {
+	if err == syscall.EPERM {
+		// Unwrapped, from a CopyOnWriteFs for a file in its base layer.
+		base := fs.Fs
+		if afs, ok := base.(*accessFs); ok {
+			base = afs.Fs
+		}
+		switch base.(type) {
+		case *afero.CopyOnWriteFs, CopyOnWriteFs:
+			return true
+		}
+		return false
+	}
+	if lerr, ok := err.(*os.LinkError); ok {
+		err = lerr.Err
+	}
+	return err == syscall.EXDEV
+}
+
+// Moves the file or dir tree at src to dst, falling back to copying and removing.
+// Returns a warning if the copy succeeded but the source couldn't be removed.
+func (fs FS) move(ctx context.Context, src, dst string, overwrite bool) (*string, error) {
+	src = cleanPath(src)
+	dst = cleanPath(dst)
+	if src == dst {
+		return nil, errors.New("Cannot move a file to itself")
+	}
+	if isPathWithin(dst, src) {
+		return nil, errors.New("Cannot move a file into itself")
+	}
+	if _, err := fs.Lstat(src); err != nil {
+		return nil, err
+	}
+	_, err := fs.Lstat(dst)
+	dstExists := err == nil
+	if dstExists && !overwrite {
+		return nil, &os.PathError{Op: "move", Path: dst, Err: os.ErrExist}
+	}
+	err = fs.checkMovedSymlinks(ctx, src, dst)
+	if err != nil {
+		return nil, err
+	}
+	// Rename atomically replaces a file or empty dir.
+	err = fs.Rename(src, dst)
+	if err == nil || !fs.isRenameUnsupported(err) {
+		return nil, err
+	}
+	if dstExists {
+		// Only replaces a file or empty dir, like rename.
+		err = fs.Remove(dst)
+		if err != nil {
+			return nil, err
+		}
+	}
+	c := &copier{
+		ctx:           ctx,
+		fs:            fs,
+		preserveMode:  true,
+		preserveTimes: true,
+	}
+	err = c.copy(src, dst, true)
+	if err != nil {
+		return nil, err
+	}
+	err = fs.RemoveAll(src)
+	if err == nil {
+		if _, lerr := fs.Lstat(src); lerr == nil {
+			// Such as a base layer file in a CopyOnWriteFs.
+			err = &os.PathError{Op: "remove", Path: src, Err: syscall.EPERM}
+		}
+	}
+	if err != nil {
+		c.warn("copied, but unable to remove source: " + err.Error())
+	}
+	return c.warning(), nil
+}
+
+// Returns an error if a relative symlink at or in src would lead outside the root once moved to dst,
+// as rename doesn't check symlinks moved to a different depth.
+func (fs FS) checkMovedSymlinks(ctx context.Context, src, dst string) error {
+	lr, ok := fs.Fs.(afero.LinkReader)
+	if !ok {
+		return nil
+	}
+	return afero.Walk(fs.Fs, src, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if fi.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+		fpath = filepath.ToSlash(fpath)
+		target, err := lr.ReadlinkIfPossible(fpath)
+		if err != nil || path.IsAbs(target) {
+			return err
+		}
+		dir, err := fs.resolveSymlinks(path.Dir(path.Join(dst, strings.TrimPrefix(fpath, src))), true)
+		if err != nil {
+			return err
+		}
+		_, err = fs.resolveTarget(dir, target)
+		return err
+	})
+}
@@ -0,0 +1,164 @@
+package fsgraph
+
+import (
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Parses a time in the same format as File.modTime, or any RFC 3339 time.
+func parseTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Invalid time")
+	}
+	return t, nil
+}
+
+// fileMatcher is a FileFilter prepared for matching.
+type fileMatcher struct {
+	filter         FileFilter
+	types          map[FileType]bool
+	modifiedAfter  time.Time
+	modifiedBefore time.Time
+}
+
+// Returns a matcher for the filter, a nil filter matches all files.
+func newFileMatcher(filter *FileFilter) (*fileMatcher, error) {
+	m := &fileMatcher{}
+	if filter == nil {
+		m.filter.IncludeHidden = true
+		return m, nil
+	}
+	m.filter = *filter
+	if filter.Glob != nil {
+		if _, err := path.Match(*filter.Glob, ""); err != nil {
+			return nil, errors.Wrap(err, "Invalid glob")
+		}
+	}
+	if len(filter.Types) > 0 {
+		m.types = make(map[FileType]bool, len(filter.Types))
+		for _, ft := range filter.Types {
+			m.types[ft] = true
+		}
+	}
+	if filter.ModifiedAfter != nil {
+		t, err := parseTime(*filter.ModifiedAfter)
+		if err != nil {
+			return nil, err
+		}
+		m.modifiedAfter = t
+	}
+	if filter.ModifiedBefore != nil {
+		t, err := parseTime(*filter.ModifiedBefore)
+		if err != nil {
+			return nil, err
+		}
+		m.modifiedBefore = t
+	}
+	return m, nil
+}
+
+// Reports whether the pattern matches the slash separated path.
+// A ** element in the pattern matches zero or more path elements.
+func matchGlob(pattern, fpath string) bool {
+	return matchGlobParts(strings.Split(pattern, "/"), strings.Split(fpath, "/"))
+}
+
+func matchGlobParts(pattern, parts []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(parts); i++ {
+				if matchGlobParts(pattern[1:], parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(parts) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], parts[0]); !ok {
+			return false
+		}
+		pattern, parts = pattern[1:], parts[1:]
+	}
+	return len(parts) == 0
+}
+
+// Reports whether the glob matches the file, rel is the path relative to the dir being listed.
+// A glob containing a slash is matched against rel, otherwise against the file's name.
+func matchFileGlob(glob, rel string) bool {
+	if strings.Contains(glob, "/") {
+		return matchGlob(glob, rel)
+	}
+	ok, _ := path.Match(glob, path.Base(rel))
+	return ok
+}
+
+// Reports whether the hidden setting excludes the file.
+func (m *fileMatcher) hides(fi os.FileInfo) bool {
+	return !m.filter.IncludeHidden && strings.HasPrefix(fi.Name(), ".")
+}
+
+// Reports whether the file matches, rel is the path relative to the dir being listed.
+func (m *fileMatcher) match(rel string, fi os.FileInfo) bool {
+	if m.hides(fi) {
+		return false
+	}
+	if m.filter.Glob != nil && !matchFileGlob(*m.filter.Glob, rel) {
+		return false
+	}
+	if m.types != nil && !m.types[fileTypeFromOsFileMode(fi.Mode())] {
+		return false
+	}
+	if m.filter.MinSize != nil && fi.Size() < int64(*m.filter.MinSize) {
+		return false
+	}
+	if m.filter.MaxSize != nil && fi.Size() > int64(*m.filter.MaxSize) {
+		return false
+	}
+	if !m.modifiedAfter.IsZero() && fi.ModTime().Before(m.modifiedAfter) {
+		return false
+	}
+	if !m.modifiedBefore.IsZero() && !fi.ModTime().Before(m.modifiedBefore) {
+		return false
+	}
+	return true
+}
+
+// Sorts the list according to order, ties are ordered by name.
+func sortFileInfos(list []os.FileInfo, order FileOrder) {
+	less := func(a, b os.FileInfo) bool {
+		switch order.Field {
+		case FileOrderFieldSize:
+			if a.Size() != b.Size() {
+				return a.Size() < b.Size()
+			}
+		case FileOrderFieldModTime:
+			if !a.ModTime().Equal(b.ModTime()) {
+				return a.ModTime().Before(b.ModTime())
+			}
+		case FileOrderFieldType:
+			at, bt := fileTypeFromOsFileMode(a.Mode()), fileTypeFromOsFileMode(b.Mode())
+			if at != bt {
+				return at < bt
+			}
+		}
+		return a.Name() < b.Name()
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if order.DirsFirst && a.IsDir() != b.IsDir() {
+			return a.IsDir()
+		}
+		if order.Direction == OrderDirectionDesc {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+}
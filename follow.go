@@ -0,0 +1,196 @@
+package fsgraph
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// How often a followed file is checked for changes.
+var followPollInterval = 250 * time.Millisecond
+
+// The most data in a single follow event.
+const followChunkSize = 64 * 1024
+
+type follower struct {
+	ctx      context.Context
+	fs       FS
+	path     string
+	encoding Encoding
+	ch       chan FollowEvent
+	f        afero.File
+	fi       os.FileInfo
+	offset   int64  // Of the next data to emit.
+	partial  []byte // A partial UTF-8 sequence at offset, not yet emitted.
+	buf      []byte
+}
+
+// Returns the data appended to the file at fpath, the channel is closed when ctx is done.
+func (fs FS) follow(ctx context.Context, fpath string, fromEnd bool, encoding Encoding) (<-chan FollowEvent, error) {
+	switch encoding {
+	case EncodingAuto, EncodingUtf8, EncodingBase64:
+	default:
+		return nil, ErrInvalidEncoding
+	}
+	fpath = cleanPath(fpath)
+	f, err := fs.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() {
+		f.Close()
+		return nil, errors.New("Cannot follow a file which is not a regular file")
+	}
+	fl := &follower{
+		ctx:      ctx,
+		fs:       fs,
+		path:     fpath,
+		encoding: encoding,
+		ch:       make(chan FollowEvent),
+		f:        f,
+		fi:       fi,
+		buf:      make([]byte, followChunkSize),
+	}
+	if fromEnd {
+		fl.offset = fi.Size()
+	}
+	go fl.run()
+	return fl.ch, nil
+}
+
+// Reports whether a and b are the same file, true if it can't be determined.
+func sameFile(a, b os.FileInfo) bool {
+	if a.Sys() == nil || b.Sys() == nil {
+		return true // Such as a MemMapFs.
+	}
+	return os.SameFile(a, b)
+}
+
+func (fl *follower) send(ev FollowEvent) bool {
+	select {
+	case fl.ch <- ev:
+		return true
+	case <-fl.ctx.Done():
+		return false
+	}
+}
+
+func (fl *follower) run() {
+	defer close(fl.ch)
+	defer func() {
+		fl.f.Close()
+	}()
+	ticker := time.NewTicker(followPollInterval)
+	defer ticker.Stop()
+	for {
+		if !fl.check() {
+			return
+		}
+		select {
+		case <-fl.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Checks the file for changes and emits them, returns false if the follow is done.
+func (fl *follower) check() bool {
+	fi, err := fl.fs.Stat(fl.path)
+	if err != nil {
+		// Such as between the file being rotated and recreated,
+		// but the rest of the current file can still be read.
+		return fl.readAppended()
+	}
+	if !sameFile(fi, fl.fi) {
+		f, err := fl.fs.Open(fl.path)
+		if err != nil {
+			return fl.readAppended()
+		}
+		if !fl.readAppended() {
+			f.Close()
+			return false
+		}
+		fl.f.Close()
+		fl.f, fl.fi = f, fi
+		fl.offset, fl.partial = 0, nil
+		if !fl.send(FollowEvent{Type: FollowEventTypeRotated, Encoding: EncodingUtf8}) {
+			return false
+		}
+	} else if fi.Size() < fl.offset+int64(len(fl.partial)) {
+		fl.offset, fl.partial = 0, nil
+		if !fl.send(FollowEvent{Type: FollowEventTypeTruncated, Encoding: EncodingUtf8}) {
+			return false
+		}
+	}
+	return fl.readAppended()
+}
+
+// Emits the data from offset to the end of the current file.
+func (fl *follower) readAppended() bool {
+	for {
+		n, err := fl.f.ReadAt(fl.buf[len(fl.partial):], fl.offset+int64(len(fl.partial)))
+		if n == 0 {
+			return err == nil || err == io.EOF
+		}
+		data := append(fl.partial, fl.buf[len(fl.partial):len(fl.partial)+n]...)
+		fl.partial = nil
+		if fl.encoding != EncodingBase64 {
+			// Hold back a partial UTF-8 sequence at the end.
+			for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+				if utf8.RuneStart(data[i]) {
+					if !utf8.FullRune(data[i:]) {
+						fl.partial = append([]byte{}, data[i:]...)
+						data = data[:i]
+					}
+					break
+				}
+			}
+		}
+		if len(data) > 0 {
+			ev := FollowEvent{Type: FollowEventTypeData, Offset: Int64(fl.offset)}
+			ev.Data, ev.Encoding, ev.Warning = encodeChunk(data, fl.encoding)
+			fl.offset += int64(len(data))
+			ev.Next = Int64(fl.offset)
+			if !fl.send(ev) {
+				return false
+			}
+		}
+		if err != nil {
+			return err == io.EOF
+		}
+	}
+}
+
+// Encodes data like RegularFile.contents does.
+func encodeChunk(data []byte, encoding Encoding) (string, Encoding, *string) {
+	switch encoding {
+	case EncodingAuto:
+		s := string(data)
+		if utf8.ValidString(s) && !looksBinary(s) {
+			return s, EncodingUtf8, nil
+		}
+		return base64.StdEncoding.EncodeToString(data), EncodingBase64, nil
+	case EncodingUtf8:
+		s := string(data)
+		if utf8.ValidString(s) {
+			return s, EncodingUtf8, nil
+		}
+		warning := "Invalid UTF-8 encountered"
+		return strings.ToValidUTF8(s, "\uFFFD"), EncodingUtf8, &warning
+	default:
+		return base64.StdEncoding.EncodeToString(data), EncodingBase64, nil
+	}
+}
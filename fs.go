@@ -3,11 +3,25 @@ package fsgraph
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
+	"mime"
+	"net/http"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -18,6 +32,10 @@ import (
 type FS struct {
 	afero.Fs
 	Scope []byte
+	// RawURL is the URL prefix where RawHandler serves this FS, for RegularFile.downloadURL
+	RawURL string
+	// Access limits what each Identity can access, if set; applied per request by the resolvers and RawHandler.
+	Access *AccessRules
 }
 
 func (fs FS) genID(path string) string {
@@ -33,8 +51,75 @@ func (fs FS) ID() string {
 	return fs.genID("")
 }
 
+// ErrInvalidID is returned if an ID can't be decoded.
+var ErrInvalidID = errors.New("Invalid ID")
+
+// Before the path in the ID of a file reached by following a symlink.
+const followIDPrefix = "->"
+
+// Returns the path encoded in the ID, and whether to follow a symlink at it,
+// ok is false if the ID isn't in this FS's scope.
+func (fs FS) parseID(id string) (path string, follow bool, ok bool, err error) {
+	data, err := base64.StdEncoding.DecodeString(id)
+	if err != nil {
+		return "", false, false, ErrInvalidID
+	}
+	if !bytes.HasPrefix(data, fs.Scope) {
+		return "", false, false, nil
+	}
+	path = string(data[len(fs.Scope):])
+	if strings.HasPrefix(path, followIDPrefix) {
+		path = path[len(followIDPrefix):]
+		follow = true
+	}
+	if len(path) == 0 || path[0] != '/' {
+		return "", false, false, nil
+	}
+	return path, follow, true, nil
+}
+
+// GetNode returns the Node for the ID, or nil if it doesn't exist or is denied.
+func (fs FS) GetNode(id string) (Node, error) {
+	path, follow, ok, err := fs.parseID(id)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var f File
+	if follow {
+		f, err = fs.GetFile(path)
+	} else {
+		f, err = fs.GetFileNoFollow(path)
+	}
+	if err != nil {
+		if os.IsNotExist(err) || os.IsPermission(err) {
+			return nil, nil // Denied is the same as missing, so it doesn't tell it exists.
+		}
+		return nil, err
+	}
+	node, _ := f.(Node)
+	return node, nil
+}
+
+// Lstat is like Stat, but does not follow a final symlink if the Fs supports it.
+func (fs FS) Lstat(path string) (os.FileInfo, error) {
+	if lstater, ok := fs.Fs.(afero.Lstater); ok {
+		fi, _, err := lstater.LstatIfPossible(path)
+		return fi, err
+	}
+	return fs.Stat(path)
+}
+
 func (fs FS) GetFile(path string) (File, error) {
-	fi, err := fs.Stat(path)
+	fb, err := fs.getFollowed(path)
+	if err != nil {
+		return nil, err
+	}
+	return getFsFileFromBase(fb), nil
+}
+
+// GetFileNoFollow is like GetFile, but returns a Symlink rather than following it.
+func (fs FS) GetFileNoFollow(path string) (File, error) {
+	fi, err := fs.Lstat(path)
 	if err != nil {
 		return nil, err
 	}
@@ -42,25 +127,44 @@ func (fs FS) GetFile(path string) (File, error) {
 }
 
 func (fs FS) GetDir(dirPath string) (Dir, error) {
-	fi, err := fs.Stat(dirPath)
+	fb, err := fs.getFollowed(dirPath)
 	if err != nil {
 		return Dir{}, err
 	}
-	if !fi.IsDir() {
+	if !fb.IsDir() {
 		return Dir{}, errors.New("Not a directory")
 	}
-	return Dir{makeFileBase(dirPath, fi, fs)}, nil
+	return Dir{fb}, nil
+}
+
+// Returns the fileBase for the file at fpath, following a symlink.
+func (fs FS) getFollowed(fpath string) (fileBase, error) {
+	fi, err := fs.Stat(fpath)
+	if err != nil {
+		return fileBase{}, err
+	}
+	fb := makeFileBase(fpath, fi, fs)
+	if lfi, err := fs.Lstat(fpath); err == nil && lfi.Mode()&os.ModeSymlink != 0 {
+		fb.followed = true
+	}
+	return fb, nil
 }
 
 type fileBase struct {
 	Path string `json:"path"`
 	os.FileInfo
-	fs FS
+	fs       FS
+	followed bool // Reached by following a symlink at Path, so its ID refetches it the same way.
 }
 
 func (fileBase) IsFile() {}
 
+func (fileBase) IsNode() {}
+
 func (fb fileBase) ID() string {
+	if fb.followed {
+		return fb.fs.genID(followIDPrefix + fb.Path)
+	}
 	return fb.fs.genID(fb.Path)
 }
 
@@ -118,16 +222,24 @@ func (fb fileBase) ModTime() string {
 	return fb.FileInfo.ModTime().UTC().Format(timeFmt)
 }
 
+func fileVersion(fi os.FileInfo) string {
+	return fmt.Sprintf("%x-%x", fi.Size(), fi.ModTime().UnixNano()) + fileChangeVersion(fi)
+}
+
+func (fb fileBase) Version() string {
+	return fileVersion(fb.FileInfo)
+}
+
 func (fb fileBase) getParent() (File, error) {
 	ppath := path.Join(fb.Path, "..")
 	if ppath == fb.Path {
 		return nil, nil // no parent
 	}
-	fi, err := fb.fs.Stat(ppath)
+	pb, err := fb.fs.getFollowed(ppath)
 	if err != nil {
 		return nil, err
 	}
-	return Dir{makeFileBase(ppath, fi, fb.fs)}, nil
+	return Dir{pb}, nil
 }
 
 func makeFileBase(path string, fi os.FileInfo, fs FS) fileBase {
@@ -136,17 +248,30 @@ func makeFileBase(path string, fi os.FileInfo, fs FS) fileBase {
 	} else if path[0] != '/' {
 		path = "/" + path
 	}
-	return fileBase{path, fi, fs}
+	return fileBase{Path: path, FileInfo: fi, fs: fs}
 }
 
 func getFsFileFromInfo(path string, fi os.FileInfo, fs FS) (File, error) {
-	switch fi.Mode() & (os.ModeType | os.ModeCharDevice) {
+	return getFsFileFromBase(makeFileBase(path, fi, fs)), nil
+}
+
+// Returns the File of the type for the fileBase's mode.
+func getFsFileFromBase(fb fileBase) File {
+	switch fb.FileInfo.Mode() & (os.ModeType | os.ModeCharDevice) {
 	case 0: // regular:
-		return RegularFile{makeFileBase(path, fi, fs)}, nil
+		return RegularFile{fb}
 	case os.ModeDir:
-		return Dir{makeFileBase(path, fi, fs)}, nil
+		return Dir{fb}
+	case os.ModeSymlink:
+		return Symlink{fb}
+	case os.ModeNamedPipe:
+		return NamedPipe{fb}
+	case os.ModeSocket:
+		return Socket{fb}
+	case os.ModeDevice, os.ModeDevice | os.ModeCharDevice:
+		return Device{fb}
 	default:
-		return Internal_OtherFile{makeFileBase(path, fi, fs)}, nil
+		return IrregularFile{fb}
 	}
 }
 
@@ -157,6 +282,46 @@ type RegularFile struct {
 	fileBase
 }
 
+// Reports whether the valid utf8 string looks like binary data, based on its control chars.
+func looksBinary(s string) bool {
+	nlow := 0
+	nnul := 0
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if b < 32 && b != '\n' && b != '\r' && b != '\t' && b != '\v' {
+			nlow++
+			if b == 0 {
+				nnul++
+			}
+		}
+	}
+	// Decide if it looks like binary. This could probably use some tweaking.
+	return nlow > 0 && (nnul > len(s)/16 || nlow >= len(s)/4)
+}
+
+// The number of bytes at the start of a file used to decide if it's binary.
+const textCheckSize = 8 * 1024
+
+// Reports whether the data looks like binary, using the same rules as auto encoding.
+// If partial, the data is only the start of the file and may end with a truncated rune.
+func looksBinaryData(data []byte, partial bool) bool {
+	if partial {
+		// Trim a truncated rune from the end.
+		for i := 1; i < utf8.UTFMax && i <= len(data); i++ {
+			if utf8.RuneStart(data[len(data)-i]) {
+				if !utf8.FullRune(data[len(data)-i:]) {
+					data = data[:len(data)-i]
+				}
+				break
+			}
+		}
+	}
+	if !utf8.Valid(data) {
+		return true
+	}
+	return looksBinary(string(data))
+}
+
 func (rf RegularFile) getContents(ctx context.Context, encoding Encoding, maxReadBytes int64, seek int64) (FileContents, error) {
 	f, err := rf.fs.Open(rf.Path)
 	if err != nil {
@@ -198,19 +363,7 @@ func (rf RegularFile) getContents(ctx context.Context, encoding Encoding, maxRea
 				// This is somewhat magic and not guaranteed to return a consistent encoding,
 				// but if you handle both utf8 and base64 you'll get the correct content (if no warnings)
 				// Note: If you always want a specific encoding or consistency, don't use auto.
-				nlow := 0
-				nnul := 0
-				for i := 0; i < len(s); i++ {
-					b := s[i]
-					if b < 32 && b != '\n' && b != '\r' && b != '\t' && b != '\v' {
-						nlow++
-						if b == 0 {
-							nnul++
-						}
-					}
-				}
-				// Decide if it looks like binary. This could probably use some tweaking.
-				if nlow > 0 && (nnul > len(s)/16 || nlow >= len(s)/4) {
+				if looksBinary(s) {
 					fc.Data = base64.StdEncoding.EncodeToString([]byte(s))
 					fc.Encoding = EncodingBase64
 				}
@@ -273,11 +426,89 @@ func (rf RegularFile) getContents(ctx context.Context, encoding Encoding, maxRea
 	return fc, nil
 }
 
+// Returns up to n bytes from the start of the file.
+func (rf RegularFile) readHead(n int) ([]byte, error) {
+	f, err := rf.fs.Open(rf.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	buf := make([]byte, n)
+	nread, err := io.ReadFull(f, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return buf[:nread], nil
+}
+
+// The MIME type is from the file's extension if known, otherwise sniffed from its contents.
+func (rf RegularFile) getMimeType() (*string, error) {
+	mimeType := mime.TypeByExtension(path.Ext(rf.Path))
+	if mimeType == "" {
+		head, err := rf.readHead(512)
+		if err != nil {
+			return nil, nil // Null if it can't be read, as documented.
+		}
+		mimeType = http.DetectContentType(head)
+	}
+	return &mimeType, nil
+}
+
+func (rf RegularFile) getIsText() (*bool, error) {
+	head, err := rf.readHead(textCheckSize)
+	if err != nil {
+		return nil, nil
+	}
+	isText := !looksBinaryData(head, len(head) == textCheckSize)
+	return &isText, nil
+}
+
+// ErrInvalidHashAlgorithm is returned if the HashAlgorithm is not valid.
+var ErrInvalidHashAlgorithm = errors.New("Invalid hash algorithm")
+
+func newHash(algorithm HashAlgorithm) (hash.Hash, error) {
+	switch algorithm {
+	case HashAlgorithmSha256:
+		return sha256.New(), nil
+	case HashAlgorithmSha512:
+		return sha512.New(), nil
+	case HashAlgorithmSha1:
+		return sha1.New(), nil
+	case HashAlgorithmMd5:
+		return md5.New(), nil
+	case HashAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	default:
+		return nil, ErrInvalidHashAlgorithm
+	}
+}
+
+func (rf RegularFile) getHash(ctx context.Context, algorithm HashAlgorithm) (string, error) {
+	h, err := newHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+	f, err := rf.fs.Open(rf.Path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 type Dir struct {
 	fileBase
 }
 
-func (dir Dir) getChildren(ctx context.Context, first int) ([]File, error) {
+func (dir Dir) getChildren(ctx context.Context, first int, orderBy *FileOrder, filter *FileFilter) ([]File, error) {
+	matcher, err := newFileMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
 	f, err := dir.fs.Open(dir.Path)
 	if err != nil {
 		return nil, err
@@ -287,6 +518,18 @@ func (dir Dir) getChildren(ctx context.Context, first int) ([]File, error) {
 	if err != nil {
 		return nil, err
 	}
+	if filter != nil {
+		matched := list[:0]
+		for _, fi := range list {
+			if matcher.match(fi.Name(), fi) {
+				matched = append(matched, fi)
+			}
+		}
+		list = matched
+	}
+	if orderBy != nil {
+		sortFileInfos(list, *orderBy)
+	}
 	if first >= 0 && len(list) > first {
 		list = list[:first]
 	}
@@ -302,10 +545,274 @@ func (dir Dir) getChildren(ctx context.Context, first int) ([]File, error) {
 	return results, nil
 }
 
-type Internal_OtherFile struct {
+func encodeCursor(name string) string {
+	return base64.StdEncoding.EncodeToString([]byte(name))
+}
+
+// ErrInvalidCursor is returned if a cursor can't be decoded.
+var ErrInvalidCursor = errors.New("Invalid cursor")
+
+func decodeCursor(cursor string) (string, error) {
+	name, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", ErrInvalidCursor
+	}
+	return string(name), nil
+}
+
+// Children are sorted by name, and only the files in the requested page are stat'd.
+func (dir Dir) getChildrenConnection(ctx context.Context, first *int, after *string, last *int, before *string) (FileConnection, error) {
+	if (first != nil && *first < 0) || (last != nil && *last < 0) {
+		return FileConnection{}, errors.New("first and last must not be negative")
+	}
+	f, err := dir.fs.Open(dir.Path)
+	if err != nil {
+		return FileConnection{}, err
+	}
+	defer f.Close()
+	names, err := f.Readdirnames(-1)
+	if err != nil {
+		return FileConnection{}, err
+	}
+	sort.Strings(names)
+
+	start, end := 0, len(names)
+	if after != nil {
+		name, err := decodeCursor(*after)
+		if err != nil {
+			return FileConnection{}, err
+		}
+		start = sort.Search(len(names), func(i int) bool { return names[i] > name })
+	}
+	if before != nil {
+		name, err := decodeCursor(*before)
+		if err != nil {
+			return FileConnection{}, err
+		}
+		end = sort.SearchStrings(names, name)
+	}
+	if end < start {
+		end = start
+	}
+	if first != nil && end-start > *first {
+		end = start + *first
+	}
+	if last != nil && end-start > *last {
+		start = end - *last
+	}
+
+	conn := FileConnection{
+		Edges:      []FileEdge{},
+		TotalCount: len(names),
+	}
+	for _, name := range names[start:end] {
+		fpath := path.Join(dir.Path, name)
+		fi, err := dir.fs.Lstat(fpath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue // Removed since Readdirnames.
+			}
+			return FileConnection{}, err
+		}
+		fx, err := getFsFileFromInfo(fpath, fi, dir.fs)
+		if err != nil {
+			return FileConnection{}, err
+		}
+		conn.Edges = append(conn.Edges, FileEdge{Cursor: encodeCursor(name), Node: fx})
+	}
+	conn.PageInfo.HasPreviousPage = start > 0
+	conn.PageInfo.HasNextPage = end < len(names)
+	if len(conn.Edges) > 0 {
+		startCursor := conn.Edges[0].Cursor
+		endCursor := conn.Edges[len(conn.Edges)-1].Cursor
+		conn.PageInfo.StartCursor = &startCursor
+		conn.PageInfo.EndCursor = &endCursor
+	}
+	return conn, nil
+}
+
+type Symlink struct {
 	fileBase
 }
 
+// Returns the raw link text, or nil if the FS can't read links.
+func (link Symlink) getTarget() (*string, error) {
+	lr, ok := link.fs.Fs.(afero.LinkReader)
+	if !ok {
+		return nil, nil
+	}
+	target, err := lr.ReadlinkIfPossible(link.Path)
+	if err != nil {
+		if perr, ok := err.(*os.PathError); ok && perr.Err == afero.ErrNoReadlink {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &target, nil
+}
+
+// Returns the file the link resolves to, or nil if it doesn't exist.
+func (link Symlink) getResolved() (File, error) {
+	target, err := link.getTarget()
+	if err != nil || target == nil {
+		return nil, err
+	}
+	tpath := *target
+	if !path.IsAbs(tpath) {
+		tpath = path.Join(path.Dir(link.Path), tpath)
+	}
+	f, err := link.fs.GetFile(path.Clean(tpath))
+	if err != nil && os.IsNotExist(err) {
+		return nil, nil
+	}
+	return f, err
+}
+
+type NamedPipe struct {
+	fileBase
+}
+
+type Socket struct {
+	fileBase
+}
+
+type Device struct {
+	fileBase
+}
+
+func (dev Device) Major() *int {
+	major, _, ok := deviceNumbers(dev.FileInfo)
+	if !ok {
+		return nil
+	}
+	return &major
+}
+
+func (dev Device) Minor() *int {
+	_, minor, ok := deviceNumbers(dev.FileInfo)
+	if !ok {
+		return nil
+	}
+	return &minor
+}
+
+type IrregularFile struct {
+	fileBase
+}
+
+// ConflictError is returned if an operation's precondition doesn't hold.
+type ConflictError struct {
+	Path   string
+	Reason string
+}
+
+func (err *ConflictError) Error() string {
+	return "Conflict: " + err.Path + ": " + err.Reason
+}
+
+// IsConflict reports whether the error is a ConflictError.
+func IsConflict(err error) bool {
+	_, ok := errors.Cause(err).(*ConflictError)
+	return ok
+}
+
+// Returns a ConflictError if any of the preconditions don't hold for the file.
+func (fs FS) checkPreconditions(fpath string, ifMatch *string, ifUnmodifiedSince *string, ifNotExists bool) error {
+	if ifMatch == nil && ifUnmodifiedSince == nil && !ifNotExists {
+		return nil
+	}
+	var since time.Time
+	if ifUnmodifiedSince != nil {
+		var err error
+		since, err = parseTime(*ifUnmodifiedSince)
+		if err != nil {
+			return err
+		}
+	}
+	fi, err := fs.Stat(fpath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		if ifMatch != nil {
+			return &ConflictError{fpath, "file does not exist"}
+		}
+		return nil
+	}
+	if ifNotExists {
+		return &ConflictError{fpath, "file exists"}
+	}
+	if ifMatch != nil && *ifMatch != "*" && *ifMatch != fileVersion(fi) {
+		return &ConflictError{fpath, "version does not match"}
+	}
+	// Compare at the same precision as File.modTime
+	if ifUnmodifiedSince != nil && fi.ModTime().Truncate(time.Millisecond).After(since) {
+		return &ConflictError{fpath, "modified since " + *ifUnmodifiedSince}
+	}
+	return nil
+}
+
+// Makes sure a write to fpath changed its version from old, if it existed,
+// moving the mod time on when the write was too quick for the clock to.
+func (fs FS) newVersion(fpath string, old string) {
+	if old == "" {
+		return
+	}
+	fi, err := fs.Stat(fpath)
+	if err != nil || fileVersion(fi) != old {
+		return
+	}
+	mtime := fi.ModTime().Add(time.Nanosecond)
+	fs.Chtimes(fpath, mtime, mtime)
+}
+
+// A lock on a path, shared by everyone locking it.
+type pathLock struct {
+	sync.Mutex
+	refs int
+}
+
+var pathLocksMu sync.Mutex
+var pathLocks = map[string]*pathLock{}
+
+// Locks the paths, in order so two callers can't deadlock, until the returned func is called.
+// Used to keep preconditions true until the operation they guard is done.
+func lockPaths(fpaths ...string) (unlock func()) {
+	keys := make([]string, 0, len(fpaths))
+	for _, fpath := range fpaths {
+		keys = append(keys, cleanPath(fpath))
+	}
+	sort.Strings(keys)
+	var locked []string
+	for i, key := range keys {
+		if i > 0 && key == keys[i-1] {
+			continue
+		}
+		pathLocksMu.Lock()
+		pl := pathLocks[key]
+		if pl == nil {
+			pl = &pathLock{}
+			pathLocks[key] = pl
+		}
+		pl.refs++
+		pathLocksMu.Unlock()
+		pl.Lock()
+		locked = append(locked, key)
+	}
+	return func() {
+		pathLocksMu.Lock()
+		defer pathLocksMu.Unlock()
+		for _, key := range locked {
+			pl := pathLocks[key]
+			pl.Unlock()
+			pl.refs--
+			if pl.refs == 0 {
+				delete(pathLocks, key)
+			}
+		}
+	}
+}
+
 type FileResult struct {
 	S       string  `json:"s"`
 	Warning *string `json:"warning"`
@@ -352,3 +859,126 @@ func fileWrite(f afero.File, contents string, encoding Encoding) error {
 		return ErrInvalidEncoding
 	}
 }
+
+// The mode of a new file replacing a path, typical for a new file, since OpenFile's 0666 is subject to umask.
+const replacePerm = os.FileMode(0644)
+
+// Writes a temp file in the same dir as fpath with write, which then replaces fpath with the mode perm,
+// so readers see either the old or new contents, and a failed write leaves fpath as is.
+func writeReplace(fs FS, fpath string, perm os.FileMode, write func(f afero.File) error) error {
+	f, err := afero.TempFile(fs.Fs, path.Dir(fpath), "."+path.Base(fpath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmppath := path.Join(path.Dir(fpath), path.Base(filepath.ToSlash(f.Name())))
+	err = write(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = renameReplace(fs, tmppath, fpath, perm)
+	}
+	if err != nil {
+		fs.Remove(tmppath)
+		return err
+	}
+	return nil
+}
+
+// Renames tmppath over fpath, with the mode perm.
+func renameReplace(fs FS, tmppath, fpath string, perm os.FileMode) error {
+	err := fs.Chmod(tmppath, perm)
+	if err != nil {
+		return err
+	}
+	return fs.Rename(tmppath, fpath)
+}
+
+// Writes the file by writing a temp file in the same dir and renaming it over the target,
+// so readers see either the old or new contents. The flags are emulated as for OpenFile.
+func fileWriteAtomic(fs FS, fpath string, flags int, contents string, encoding Encoding) error {
+	fpath = cleanPath(fpath)
+	perm := replacePerm
+	keep := false // Whether to keep the existing contents.
+	fi, err := fs.Stat(fpath)
+	if err != nil {
+		if !os.IsNotExist(err) || flags&os.O_CREATE == 0 {
+			return err
+		}
+	} else {
+		if flags&os.O_EXCL != 0 {
+			return &os.PathError{Op: "open", Path: fpath, Err: os.ErrExist}
+		}
+		if fi.IsDir() {
+			return &os.PathError{Op: "open", Path: fpath, Err: errors.New("is a directory")}
+		}
+		perm = fi.Mode().Perm()
+		keep = flags&os.O_TRUNC == 0
+	}
+
+	return writeReplace(fs, fpath, perm, func(f afero.File) error {
+		if keep {
+			src, err := fs.Open(fpath)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, src)
+			src.Close()
+			if err != nil {
+				return err
+			}
+			if flags&os.O_APPEND == 0 {
+				_, err = f.Seek(0, io.SeekStart)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return fileWrite(f, contents, encoding)
+	})
+}
+
+// Removes the file or dir tree, returning the paths (which would be) removed.
+func (fs FS) removeAll(ctx context.Context, fpath string, dryRun bool) (RemoveAllResult, error) {
+	fpath = cleanPath(fpath)
+	if fpath == "/" {
+		return RemoveAllResult{}, errors.New("Cannot remove the root directory")
+	}
+	result := RemoveAllResult{
+		Paths: []string{},
+	}
+	err := afero.Walk(fs.Fs, fpath, func(wpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result.Paths = append(result.Paths, filepath.ToSlash(wpath))
+		if fi.Mode().IsRegular() {
+			result.Bytes += Int64(fi.Size())
+		}
+		return nil
+	})
+	if err != nil {
+		if os.IsNotExist(err) && len(result.Paths) == 0 {
+			warning := err.Error()
+			result.Warning = &warning
+			return result, nil
+		}
+		return RemoveAllResult{}, err
+	}
+	if dryRun {
+		result.S = "dry run, nothing removed"
+		return result, nil
+	}
+	err = fs.RemoveAll(fpath)
+	if err != nil {
+		return RemoveAllResult{}, err
+	}
+	result.S = "removed"
+	return result, nil
+}
@@ -0,0 +1,29 @@
+package fsgraph
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// Returns the major and minor device numbers of a device file, if available.
+func deviceNumbers(fi os.FileInfo) (major int, minor int, ok bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, 0, false
+	}
+	rdev := uint64(st.Rdev)
+	major = int(((rdev >> 8) & 0xfff) | ((rdev >> 32) &^ 0xfff))
+	minor = int((rdev & 0xff) | ((rdev >> 12) &^ 0xff))
+	return major, minor, true
+}
+
+// Returns more of the file's version, from its inode and change time, if available.
+// These change when the file is replaced or changed by something else, even with the same size and mod time.
+func fileChangeVersion(fi os.FileInfo) string {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("-%x-%x", st.Ino, st.Ctim.Nano())
+}
@@ -0,0 +1,18 @@
+//go:build !linux
+// +build !linux
+
+package fsgraph
+
+import (
+	"os"
+)
+
+// Returns the major and minor device numbers of a device file, if available.
+func deviceNumbers(fi os.FileInfo) (major int, minor int, ok bool) {
+	return 0, 0, false
+}
+
+// Returns more of the file's version, from its inode and change time, if available.
+func fileChangeVersion(fi os.FileInfo) string {
+	return ""
+}
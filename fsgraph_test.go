@@ -1,10 +1,25 @@
 package fsgraph
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
 	"io/ioutil"
+	"mime/multipart"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/handler"
@@ -59,3 +74,1239 @@ func TestFSGraph(t *testing.T) {
 	require.Equal(t, 3, len(resp.Root.Children), "length of root's children")
 
 }
+
+func newTestClient(t *testing.T, fs FS) *client.Client {
+	srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(Config{
+		Resolvers: &Resolver{
+			RootFS: fs,
+		},
+	})))
+	t.Cleanup(srv.Close)
+	return client.New(srv.URL)
+}
+
+// lockedFs can't open the dir, like one without read permission.
+type lockedFs struct {
+	afero.Fs
+	dir string
+}
+
+func (fs lockedFs) Open(name string) (afero.File, error) {
+	if filepath.ToSlash(name) == fs.dir {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrPermission}
+	}
+	return fs.Fs.Open(name)
+}
+
+func TestSymlink(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "fsgraph-test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(tempdir)
+	rootfs := afero.NewBasePathFs(afero.NewOsFs(), tempdir)
+
+	afero.WriteFile(rootfs, "/file1", []byte(`File one.`), 0666)
+	err = os.Symlink("file1", filepath.Join(tempdir, "link1"))
+	require.NoError(t, err)
+
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		Root struct {
+			Children []struct {
+				Typename string  `json:"__typename"`
+				Name     string  `json:"name"`
+				Target   *string `json:"target"`
+			} `json:"children"`
+		} `json:"root"`
+	}
+	c.MustPost(`query { root { children { __typename, name, ... on Symlink { target } } } }`, &resp)
+	require.Equal(t, 2, len(resp.Root.Children), "length of root's children")
+	for _, child := range resp.Root.Children {
+		if child.Name == "link1" {
+			require.Equal(t, "Symlink", child.Typename)
+			require.NotNil(t, child.Target)
+			require.Equal(t, "file1", *child.Target)
+		} else {
+			require.Equal(t, "RegularFile", child.Typename)
+		}
+	}
+
+	var fresp struct {
+		File struct {
+			Typename string `json:"__typename"`
+			Resolved struct {
+				Name string `json:"name"`
+			} `json:"resolved"`
+		} `json:"file"`
+	}
+	c.MustPost(`query { file(path: "/link1", followSymlinks: false) { __typename, ... on Symlink { resolved { name } } } }`, &fresp)
+	require.Equal(t, "Symlink", fresp.File.Typename)
+	require.Equal(t, "file1", fresp.File.Resolved.Name)
+
+	// The ID of a followed symlink refetches what it was followed to, and the ID of the link itself the link.
+	var iresp struct {
+		Followed struct {
+			ID string `json:"id"`
+		} `json:"followed"`
+		Link struct {
+			ID string `json:"id"`
+		} `json:"link"`
+	}
+	c.MustPost(`query { followed: file(path: "/link1") { id }, link: file(path: "/link1", followSymlinks: false) { id } }`, &iresp)
+	require.NotEqual(t, iresp.Followed.ID, iresp.Link.ID)
+	var nresp struct {
+		Followed struct {
+			Typename string `json:"__typename"`
+			ID       string `json:"id"`
+		} `json:"followed"`
+		Link struct {
+			Typename string `json:"__typename"`
+		} `json:"link"`
+	}
+	c.MustPost(`query($followed: ID!, $link: ID!) { followed: node(id: $followed) { __typename, id }, link: node(id: $link) { __typename } }`,
+		&nresp, client.Var("followed", iresp.Followed.ID), client.Var("link", iresp.Link.ID))
+	require.Equal(t, "RegularFile", nresp.Followed.Typename)
+	require.Equal(t, iresp.Followed.ID, nresp.Followed.ID)
+	require.Equal(t, "Symlink", nresp.Link.Typename)
+}
+
+func TestNode(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/file1", []byte(`File one.`), 0666)
+	fs := FS{Fs: rootfs, Scope: []byte("test:")}
+	c := newTestClient(t, fs)
+
+	var resp struct {
+		Node struct {
+			Typename string `json:"__typename"`
+			ID       string `json:"id"`
+		} `json:"node"`
+		Nodes []*struct {
+			ID string `json:"id"`
+		} `json:"nodes"`
+	}
+	id := fs.genID("/file1")
+	other := FS{Scope: []byte("other:")}.genID("/file1")
+	c.MustPost(`query($id: ID!, $other: ID!) { node(id: $id) { __typename, id }, nodes(ids: [$id, $other, "not base64!"]) { id } }`,
+		&resp, client.Var("id", id), client.Var("other", other))
+	require.Equal(t, "RegularFile", resp.Node.Typename)
+	require.Equal(t, id, resp.Node.ID)
+	require.Equal(t, 3, len(resp.Nodes))
+	require.NotNil(t, resp.Nodes[0])
+	require.Nil(t, resp.Nodes[1])
+	require.Nil(t, resp.Nodes[2])
+}
+
+func TestChildrenConnection(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	for _, name := range []string{"e", "b", "d", "a", "c"} {
+		afero.WriteFile(rootfs, "/dir/"+name, []byte(name), 0666)
+	}
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	type connResp struct {
+		Cd struct {
+			ChildrenConnection struct {
+				Edges []struct {
+					Cursor string `json:"cursor"`
+					Node   struct {
+						Name string `json:"name"`
+					} `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage     bool   `json:"hasNextPage"`
+					HasPreviousPage bool   `json:"hasPreviousPage"`
+					EndCursor       string `json:"endCursor"`
+				} `json:"pageInfo"`
+				TotalCount int `json:"totalCount"`
+			} `json:"childrenConnection"`
+		} `json:"cd"`
+	}
+	query := `query($first: Int, $after: String, $last: Int) {
+		cd(path: "/dir") {
+			childrenConnection(first: $first, after: $after, last: $last) {
+				edges { cursor, node { name } }
+				pageInfo { hasNextPage, hasPreviousPage, endCursor }
+				totalCount
+			}
+		}
+	}`
+
+	var resp connResp
+	c.MustPost(query, &resp, client.Var("first", 2))
+	conn := resp.Cd.ChildrenConnection
+	require.Equal(t, 5, conn.TotalCount)
+	require.Equal(t, 2, len(conn.Edges))
+	require.Equal(t, "a", conn.Edges[0].Node.Name)
+	require.Equal(t, "b", conn.Edges[1].Node.Name)
+	require.True(t, conn.PageInfo.HasNextPage)
+	require.False(t, conn.PageInfo.HasPreviousPage)
+
+	resp = connResp{}
+	c.MustPost(query, &resp, client.Var("first", 2), client.Var("after", conn.PageInfo.EndCursor))
+	conn = resp.Cd.ChildrenConnection
+	require.Equal(t, 2, len(conn.Edges))
+	require.Equal(t, "c", conn.Edges[0].Node.Name)
+	require.Equal(t, "d", conn.Edges[1].Node.Name)
+	require.True(t, conn.PageInfo.HasPreviousPage)
+
+	resp = connResp{}
+	c.MustPost(query, &resp, client.Var("last", 1))
+	conn = resp.Cd.ChildrenConnection
+	require.Equal(t, 1, len(conn.Edges))
+	require.Equal(t, "e", conn.Edges[0].Node.Name)
+	require.False(t, conn.PageInfo.HasNextPage)
+}
+
+func TestChildrenOrderFilter(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/a.go", []byte("aaa"), 0666)
+	afero.WriteFile(rootfs, "/b.go", []byte("b"), 0666)
+	afero.WriteFile(rootfs, "/c.txt", []byte("cc"), 0666)
+	afero.WriteFile(rootfs, "/.hidden.go", []byte(""), 0666)
+	rootfs.Mkdir("/z", 0777)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		Root struct {
+			Children []struct {
+				Name string `json:"name"`
+			} `json:"children"`
+		} `json:"root"`
+	}
+	c.MustPost(`query { root { children(
+		orderBy: {field: size, direction: desc},
+		filter: {glob: "*.go", includeHidden: false}
+	) { name } } }`, &resp)
+	require.Equal(t, 2, len(resp.Root.Children))
+	require.Equal(t, "a.go", resp.Root.Children[0].Name)
+	require.Equal(t, "b.go", resp.Root.Children[1].Name)
+
+	resp.Root.Children = nil
+	c.MustPost(`query { root { children(first: 2, orderBy: {dirsFirst: true}) { name } } }`, &resp)
+	require.Equal(t, 2, len(resp.Root.Children))
+	require.Equal(t, "z", resp.Root.Children[0].Name)
+	require.Equal(t, ".hidden.go", resp.Root.Children[1].Name)
+}
+
+func TestFind(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/main.go", []byte("package main"), 0666)
+	afero.WriteFile(rootfs, "/README", []byte("readme"), 0666)
+	afero.WriteFile(rootfs, "/a/a.go", []byte("package a"), 0666)
+	afero.WriteFile(rootfs, "/a/b/b.go", []byte("package b"), 0666)
+	afero.WriteFile(rootfs, "/a/b/c/c.txt", []byte("c"), 0666)
+	afero.WriteFile(rootfs, "/locked/l.go", []byte("package l"), 0666)
+	c := newTestClient(t, FS{Fs: lockedFs{rootfs, "/locked"}})
+
+	type findResp struct {
+		Find struct {
+			Edges []struct {
+				Node struct {
+					Path string `json:"path"`
+				} `json:"node"`
+				Depth int `json:"depth"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"find"`
+	}
+	query := `query($first: Int, $after: String) {
+		find(filter: {glob: "**/*.go"}, first: $first, after: $after) {
+			edges { node { path }, depth }
+			pageInfo { hasNextPage, endCursor }
+		}
+	}`
+
+	var resp findResp
+	c.MustPost(query, &resp, client.Var("first", 2))
+	require.Equal(t, 2, len(resp.Find.Edges))
+	require.Equal(t, "/a/a.go", resp.Find.Edges[0].Node.Path)
+	require.Equal(t, 2, resp.Find.Edges[0].Depth)
+	require.Equal(t, "/a/b/b.go", resp.Find.Edges[1].Node.Path)
+	require.Equal(t, 3, resp.Find.Edges[1].Depth)
+	require.True(t, resp.Find.PageInfo.HasNextPage)
+
+	after := resp.Find.PageInfo.EndCursor
+	resp = findResp{}
+	c.MustPost(query, &resp, client.Var("first", 2), client.Var("after", after))
+	require.Equal(t, 1, len(resp.Find.Edges))
+	require.Equal(t, "/main.go", resp.Find.Edges[0].Node.Path)
+	require.Equal(t, 1, resp.Find.Edges[0].Depth)
+	require.False(t, resp.Find.PageInfo.HasNextPage)
+}
+
+func TestGrep(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/a.txt", []byte("one\ntwo Foo\nthree\nfour foo\n"), 0666)
+	afero.WriteFile(rootfs, "/b.bin", []byte("foo\x00\x00\x00\x00\x01\x02"), 0666)
+	afero.WriteFile(rootfs, "/c.go", []byte("foo"), 0666)
+	afero.WriteFile(rootfs, "/locked/d.txt", []byte("foo"), 0666)
+	c := newTestClient(t, FS{Fs: lockedFs{rootfs, "/locked"}})
+
+	var resp struct {
+		Grep struct {
+			Files []struct {
+				File struct {
+					Path string `json:"path"`
+				} `json:"file"`
+				Matches []struct {
+					Line   int      `json:"line"`
+					Offset int64    `json:"offset"`
+					Column int      `json:"column"`
+					Text   string   `json:"text"`
+					Before []string `json:"before"`
+					After  []string `json:"after"`
+				} `json:"matches"`
+			} `json:"files"`
+			MatchCount int  `json:"matchCount"`
+			Truncated  bool `json:"truncated"`
+		} `json:"grep"`
+	}
+	c.MustPost(`query { grep(pattern: "foo", caseInsensitive: true, include: ["*.txt", "*.bin"], contextLines: 1) {
+		files { file { path }, matches { line, offset, column, text, before, after } }
+		matchCount, truncated
+	} }`, &resp)
+	require.Equal(t, 1, len(resp.Grep.Files))
+	require.Equal(t, "/a.txt", resp.Grep.Files[0].File.Path)
+	require.Equal(t, 2, resp.Grep.MatchCount)
+	require.False(t, resp.Grep.Truncated)
+	m := resp.Grep.Files[0].Matches[0]
+	require.Equal(t, 2, m.Line)
+	require.Equal(t, int64(8), m.Offset)
+	require.Equal(t, 4, m.Column)
+	require.Equal(t, "two Foo", m.Text)
+	require.Equal(t, []string{"one"}, m.Before)
+	require.Equal(t, []string{"three"}, m.After)
+	m = resp.Grep.Files[0].Matches[1]
+	require.Equal(t, 4, m.Line)
+	require.Equal(t, []string{}, m.After)
+
+	// The column is in the text as returned, the offset is in the file.
+	// The invalid UTF-8 is after the start, which would otherwise look binary.
+	afero.WriteFile(rootfs, "/d.txt", []byte(strings.Repeat("x\n", 5000)+"\xff\xfex foo\n"), 0666)
+	c.MustPost(`query { grep(pattern: "foo", include: ["d.txt"]) {
+		files { file { path }, matches { line, offset, column, text, before, after } }
+		matchCount, truncated
+	} }`, &resp)
+	m = resp.Grep.Files[0].Matches[0]
+	require.Equal(t, "\uFFFDx foo", m.Text)
+	require.Equal(t, "foo", m.Text[m.Column:m.Column+3])
+	require.Equal(t, 5001, m.Line)
+	require.Equal(t, int64(10004), m.Offset)
+}
+
+func TestHash(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/file1", []byte(`File one.`), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		File struct {
+			Sha256 string `json:"sha256"`
+			Crc32  string `json:"crc32"`
+		} `json:"file"`
+	}
+	c.MustPost(`query { file(path: "/file1") { ... on RegularFile { sha256: hash(algorithm: sha256), crc32: hash(algorithm: crc32) } } }`, &resp)
+	sum := sha256.Sum256([]byte(`File one.`))
+	require.Equal(t, hex.EncodeToString(sum[:]), resp.File.Sha256)
+	require.Equal(t, fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(`File one.`))), resp.File.Crc32)
+}
+
+func TestMimeType(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/a.txt", []byte("hello"), 0666)
+	afero.WriteFile(rootfs, "/img", []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR"), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	type fileResp struct {
+		MimeType string `json:"mimeType"`
+		IsText   bool   `json:"isText"`
+	}
+	var resp struct {
+		Txt fileResp `json:"txt"`
+		Img fileResp `json:"img"`
+	}
+	c.MustPost(`query {
+		txt: file(path: "/a.txt") { ... on RegularFile { mimeType, isText } }
+		img: file(path: "/img") { ... on RegularFile { mimeType, isText } }
+	}`, &resp)
+	require.Equal(t, "text/plain; charset=utf-8", resp.Txt.MimeType)
+	require.True(t, resp.Txt.IsText)
+	require.Equal(t, "image/png", resp.Img.MimeType)
+	require.False(t, resp.Img.IsText)
+
+	// Null rather than an error if it can't be read.
+	lockedc := newTestClient(t, FS{Fs: lockedFs{rootfs, "/img"}})
+	var lresp struct {
+		File struct {
+			MimeType *string `json:"mimeType"`
+			IsText   *bool   `json:"isText"`
+		} `json:"file"`
+	}
+	lockedc.MustPost(`query { file(path: "/img") { ... on RegularFile { mimeType, isText } } }`, &lresp)
+	require.Nil(t, lresp.File.MimeType)
+	require.Nil(t, lresp.File.IsText)
+}
+
+func TestLines(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/log", []byte("1\n2\n3\n4\n5\n"), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	type linesResp struct {
+		Lines []struct {
+			Number int    `json:"number"`
+			Text   string `json:"text"`
+		} `json:"lines"`
+		TotalLines int `json:"totalLines"`
+	}
+	var resp struct {
+		File struct {
+			Head linesResp `json:"head"`
+			Tail linesResp `json:"tail"`
+		} `json:"file"`
+	}
+	c.MustPost(`query { file(path: "/log") { ... on RegularFile {
+		head: lines(from: 2, count: 2) { lines { number, text }, totalLines }
+		tail: lines(count: 2, fromEnd: true) { lines { number, text }, totalLines }
+	} } }`, &resp)
+	require.Equal(t, 5, resp.File.Head.TotalLines)
+	require.Equal(t, 2, len(resp.File.Head.Lines))
+	require.Equal(t, 2, resp.File.Head.Lines[0].Number)
+	require.Equal(t, "3", resp.File.Head.Lines[1].Text)
+	require.Equal(t, 2, len(resp.File.Tail.Lines))
+	require.Equal(t, 4, resp.File.Tail.Lines[0].Number)
+	require.Equal(t, "5", resp.File.Tail.Lines[1].Text)
+
+	var mresp struct {
+		File struct {
+			Lines struct {
+				Lines []struct {
+					Number int    `json:"number"`
+					Text   string `json:"text"`
+				} `json:"lines"`
+				TotalLines int     `json:"totalLines"`
+				Warning    *string `json:"warning"`
+			} `json:"lines"`
+		} `json:"file"`
+	}
+	c.MustPost(`query { file(path: "/log") { ... on RegularFile { lines(from: 2, count: 3, fromEnd: true) { lines { number, text }, totalLines, warning } } } }`, &mresp)
+	require.Equal(t, 5, mresp.File.Lines.TotalLines)
+	require.Equal(t, 3, len(mresp.File.Lines.Lines))
+	require.Equal(t, 2, mresp.File.Lines.Lines[0].Number)
+	require.Equal(t, "4", mresp.File.Lines.Lines[2].Text)
+	require.Nil(t, mresp.File.Lines.Warning)
+
+	// A line over the limit is cut.
+	afero.WriteFile(rootfs, "/big", []byte("first\n"+strings.Repeat("x", maxLinesSize+10)+"\nlast"), 0666)
+	c.MustPost(`query { file(path: "/big") { ... on RegularFile { lines(from: 2, count: 1, fromEnd: true) { lines { number, text }, totalLines, warning } } } }`, &mresp)
+	require.Equal(t, 3, mresp.File.Lines.TotalLines)
+	require.Equal(t, 1, len(mresp.File.Lines.Lines))
+	require.Equal(t, 2, mresp.File.Lines.Lines[0].Number)
+	require.Equal(t, maxLinesSize, len(mresp.File.Lines.Lines[0].Text))
+	require.NotNil(t, mresp.File.Lines.Warning)
+}
+
+func TestCopy(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/src/a", []byte("aaa"), 0640)
+	afero.WriteFile(rootfs, "/src/sub/b", []byte("bb"), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		Copy struct {
+			File struct {
+				Path string `json:"path"`
+			} `json:"file"`
+			Files int   `json:"files"`
+			Bytes int64 `json:"bytes"`
+		} `json:"copy"`
+	}
+	c.MustPost(`mutation { copy(path: "/src", destPath: "/dst", recursive: true) { file { path }, files, bytes } }`, &resp)
+	require.Equal(t, "/dst", resp.Copy.File.Path)
+	require.Equal(t, 2, resp.Copy.Files)
+	require.Equal(t, int64(5), resp.Copy.Bytes)
+	data, err := afero.ReadFile(rootfs, "/dst/sub/b")
+	require.NoError(t, err)
+	require.Equal(t, "bb", string(data))
+	fi, err := rootfs.Stat("/dst/a")
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), fi.Mode().Perm())
+
+	err = c.Post(`mutation { copy(path: "/src/a", destPath: "/dst/a") { s } }`, &resp)
+	require.Error(t, err, "copy must not overwrite by default")
+}
+
+func TestMove(t *testing.T) {
+	base := afero.NewMemMapFs()
+	afero.WriteFile(base, "/base/a", []byte("aaa"), 0666)
+	rootfs := afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(base), afero.NewMemMapFs())
+	rootfs.MkdirAll("/x/y", 0777)
+	afero.WriteFile(rootfs, "/x/b", []byte("bb"), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		Move struct {
+			Warning *string `json:"warning"`
+			File    struct {
+				Path string `json:"path"`
+			} `json:"file"`
+		} `json:"move"`
+	}
+	c.MustPost(`mutation { move(path: "/x/b", destPath: "/x/y/b") { warning, file { path } } }`, &resp)
+	require.Equal(t, "/x/y/b", resp.Move.File.Path)
+	require.Nil(t, resp.Move.Warning)
+	_, err := rootfs.Stat("/x/b")
+	require.True(t, os.IsNotExist(err), "source must be gone")
+
+	// Base layer files can't be renamed or removed, so it's copied with a warning.
+	c.MustPost(`mutation { move(path: "/base/a", destPath: "/x/a") { warning, file { path } } }`, &resp)
+	require.Equal(t, "/x/a", resp.Move.File.Path)
+	require.NotNil(t, resp.Move.Warning)
+	data, err := afero.ReadFile(rootfs, "/x/a")
+	require.NoError(t, err)
+	require.Equal(t, "aaa", string(data))
+
+	afero.WriteFile(rootfs, "/x/c", []byte("c"), 0666)
+	err = c.Post(`mutation { move(path: "/x/c", destPath: "/x/a") { warning } }`, &resp)
+	require.Error(t, err, "destination exists")
+	c.MustPost(`mutation { move(path: "/x/c", destPath: "/x/a", overwrite: true) { warning } }`, &resp)
+	require.Nil(t, resp.Move.Warning)
+	data, err = afero.ReadFile(rootfs, "/x/a")
+	require.NoError(t, err)
+	require.Equal(t, "c", string(data))
+}
+
+func TestRemoveAll(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/build/a", []byte("aaa"), 0666)
+	afero.WriteFile(rootfs, "/build/sub/b", []byte("bb"), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		RemoveAll struct {
+			Paths []string `json:"paths"`
+			Bytes int64    `json:"bytes"`
+		} `json:"removeAll"`
+	}
+	c.MustPost(`mutation { removeAll(path: "/build", dryRun: true) { paths, bytes } }`, &resp)
+	require.Equal(t, []string{"/build", "/build/a", "/build/sub", "/build/sub/b"}, resp.RemoveAll.Paths)
+	require.Equal(t, int64(5), resp.RemoveAll.Bytes)
+	_, err := rootfs.Stat("/build/sub/b")
+	require.NoError(t, err, "dry run must not remove")
+
+	c.MustPost(`mutation { removeAll(path: "/build") { paths, bytes } }`, &resp)
+	require.Equal(t, 4, len(resp.RemoveAll.Paths))
+	_, err = rootfs.Stat("/build")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestCreateLinks(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "fsgraph-test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(tempdir)
+	rootfs := NewOsBasePathFs(tempdir)
+	afero.WriteFile(rootfs, "/file1", []byte(`File one.`), 0666)
+	rootfs.Mkdir("/dir", 0777)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		Symlink struct {
+			File struct {
+				Typename string `json:"__typename"`
+				Target   string `json:"target"`
+				Resolved struct {
+					Path string `json:"path"`
+				} `json:"resolved"`
+			} `json:"file"`
+		} `json:"symlink"`
+	}
+	c.MustPost(`mutation { symlink(target: "/file1", path: "/dir/abs") {
+		file { __typename, ... on Symlink { target, resolved { path } } }
+	} }`, &resp)
+	require.Equal(t, "Symlink", resp.Symlink.File.Typename)
+	require.Equal(t, "/file1", resp.Symlink.File.Target)
+	require.Equal(t, "/file1", resp.Symlink.File.Resolved.Path)
+
+	err = c.Post(`mutation { symlink(target: "../../etc/passwd", path: "/dir/bad") { s } }`, &resp)
+	require.Error(t, err, "symlink target outside root")
+
+	// Relative to where the link really is, through the symlinked dir.
+	rootfs.Mkdir("/d2", 0777)
+	c.MustPost(`mutation { symlink(target: "/", path: "/d1") { s } }`, &resp)
+	err = c.Post(`mutation { symlink(target: "../../outside", path: "/d1/d2/l") { s } }`, &resp)
+	require.Error(t, err, "symlink target outside root through a symlinked dir")
+	err = c.Post(`mutation { symlink(target: "../d1/..", path: "/dir/esc") { s } }`, &resp)
+	require.Error(t, err, "symlink target outside root through a symlink in the target")
+	_, err = FS{Fs: rootfs}.resolveSymlinks("/d1/d1/file1", true)
+	require.NoError(t, err)
+	c.MustPost(`mutation { symlink(target: "../file1", path: "/dir/up") { s } }`, &resp)
+	var cresp struct {
+		Copy struct {
+			S string `json:"s"`
+		} `json:"copy"`
+	}
+	err = c.Post(`mutation { copy(path: "/dir/up", destPath: "/up") { s } }`, &cresp)
+	require.Error(t, err, "copied symlink target outside root")
+	var mresp struct {
+		Move struct {
+			S string `json:"s"`
+		} `json:"move"`
+	}
+	err = c.Post(`mutation { move(path: "/dir/up", destPath: "/up") { s } }`, &mresp)
+	require.Error(t, err, "moved symlink target outside root")
+	rootfs.Mkdir("/dir/sub", 0777)
+	c.MustPost(`mutation { symlink(target: "../../file1", path: "/dir/sub/up") { s } }`, &resp)
+	err = c.Post(`mutation { move(path: "/dir/sub", destPath: "/sub") { s } }`, &mresp)
+	require.Error(t, err, "symlink in a moved dir outside root")
+	c.MustPost(`mutation { move(path: "/dir/sub", destPath: "/d2/sub") { s } }`, &mresp)
+	data, err := afero.ReadFile(rootfs, "/d2/sub/up")
+	require.NoError(t, err)
+	require.Equal(t, "File one.", string(data))
+
+	var lresp struct {
+		Link struct {
+			S string `json:"s"`
+		} `json:"link"`
+	}
+	c.MustPost(`mutation { link(existingPath: "/file1", newPath: "/dir/hard") { s } }`, &lresp)
+	data, err = afero.ReadFile(rootfs, "/dir/hard")
+	require.NoError(t, err)
+	require.Equal(t, "File one.", string(data))
+
+	memc := newTestClient(t, FS{Fs: afero.NewMemMapFs()})
+	err = memc.Post(`mutation { link(existingPath: "/a", newPath: "/b") { s } }`, &lresp)
+	require.Error(t, err, "hard links unsupported")
+
+	// Like -protected, the dir is only in the base.
+	layerdir, err := ioutil.TempDir("", "fsgraph-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(layerdir)
+	os.Chmod(filepath.Join(tempdir, "dir"), 0750)
+	cowc := newTestClient(t, FS{Fs: NewCopyOnWriteFs(rootfs, NewOsBasePathFs(layerdir))})
+	cowc.MustPost(`mutation { symlink(target: "/file1", path: "/dir/cow") { s } }`, &resp)
+	_, err = os.Lstat(filepath.Join(tempdir, "dir", "cow"))
+	require.True(t, os.IsNotExist(err), "symlink not in base")
+	infos, err := afero.ReadDir(NewOsBasePathFs(layerdir), "/dir")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(infos))
+	require.Equal(t, "cow", infos[0].Name())
+	fi, err := os.Stat(filepath.Join(layerdir, "dir"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0750), fi.Mode().Perm(), "layer dir has the base dir's mode")
+}
+
+func TestChtimes(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/file1", []byte(`File one.`), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		Chtimes struct {
+			File struct {
+				ModTime string `json:"modTime"`
+			} `json:"file"`
+		} `json:"chtimes"`
+		Touch struct {
+			S string `json:"s"`
+		} `json:"touch"`
+	}
+	c.MustPost(`mutation {
+		chtimes(path: "/file1", modTime: "2020-01-02T03:04:05.678Z") { file { modTime } }
+		touch(path: "/file2") { s }
+	}`, &resp)
+	require.Equal(t, "2020-01-02T03:04:05.678Z", resp.Chtimes.File.ModTime)
+	require.Equal(t, "file created", resp.Touch.S)
+	_, err := rootfs.Stat("/file2")
+	require.NoError(t, err)
+
+	err = c.Post(`mutation { touch(path: "/file3", createIfMissing: false) { s } }`, &resp)
+	require.Error(t, err)
+}
+
+func TestWriteAtTruncate(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/file1", []byte(`File one.`), 0666)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		WriteAt struct {
+			S string `json:"s"`
+		} `json:"writeAt"`
+	}
+	c.MustPost(`mutation { writeAt(path: "/file1", offset: 5, contents: "ONE") { s } }`, &resp)
+	data, _ := afero.ReadFile(rootfs, "/file1")
+	require.Equal(t, "File ONE.", string(data))
+
+	c.MustPost(`mutation { truncate(path: "/file1", size: 4) { s } }`, &resp)
+	data, _ = afero.ReadFile(rootfs, "/file1")
+	require.Equal(t, "File", string(data))
+}
+
+func TestWriteAtomic(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "fsgraph-test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(tempdir)
+	rootfs := afero.NewBasePathFs(afero.NewOsFs(), tempdir)
+	afero.WriteFile(rootfs, "/config", []byte(`old`), 0600)
+	c := newTestClient(t, FS{Fs: rootfs})
+
+	var resp struct {
+		Write struct {
+			S string `json:"s"`
+		} `json:"write"`
+	}
+	c.MustPost(`mutation { write(path: "/config", contents: "new", atomic: true) { s } }`, &resp)
+	c.MustPost(`mutation { write(path: "/config", contents: "er", open: [append], atomic: true) { s } }`, &resp)
+	data, _ := afero.ReadFile(rootfs, "/config")
+	require.Equal(t, "newer", string(data))
+	fi, err := rootfs.Stat("/config")
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	names, _ := afero.ReadDir(rootfs, "/")
+	require.Equal(t, 1, len(names), "no temp files left")
+}
+
+func TestPreconditions(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/file1", []byte(`File one.`), 0666)
+	fs := FS{Fs: rootfs}
+	c := newTestClient(t, fs)
+
+	var fresp struct {
+		File struct {
+			Version string `json:"version"`
+		} `json:"file"`
+	}
+	c.MustPost(`query { file(path: "/file1") { version } }`, &fresp)
+	version := fresp.File.Version
+	require.NotEmpty(t, version)
+
+	var resp struct {
+		Write struct {
+			File struct {
+				Version string `json:"version"`
+			} `json:"file"`
+		} `json:"write"`
+	}
+	c.MustPost(`mutation($v: String) { write(path: "/file1", contents: "File 1.", ifMatch: $v) { file { version } } }`,
+		&resp, client.Var("v", version))
+	require.NotEqual(t, version, resp.Write.File.Version)
+
+	// The old version no longer matches.
+	err := c.Post(`mutation($v: String) { write(path: "/file1", contents: "File one!", ifMatch: $v) { s } }`,
+		&resp, client.Var("v", version))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Conflict")
+	err = fs.checkPreconditions("/file1", nil, nil, true)
+	require.True(t, IsConflict(err))
+	data, _ := afero.ReadFile(rootfs, "/file1")
+	require.Equal(t, "File 1.", string(data))
+
+	// For rename, ifNotExists is of the new name.
+	afero.WriteFile(rootfs, "/file2", []byte(`File two.`), 0666)
+	var rresp struct {
+		Rename struct {
+			S string `json:"s"`
+		} `json:"rename"`
+	}
+	err = c.Post(`mutation { rename(path: "/file1", newName: "file2", ifNotExists: true) { s } }`, &rresp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Conflict")
+	c.MustPost(`mutation { rename(path: "/file1", newName: "file3", ifNotExists: true) { s } }`, &rresp)
+	require.Equal(t, "renamed", rresp.Rename.S)
+
+	// Only one of the writes with the same version is done.
+	c.MustPost(`query { file(path: "/file3") { version } }`, &fresp)
+	version = fresp.File.Version
+	var wg sync.WaitGroup
+	var written int32
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var resp struct{}
+			err := c.Post(`mutation($v: String, $s: String!) { write(path: "/file3", contents: $s, ifMatch: $v) { s } }`,
+				&resp, client.Var("v", version), client.Var("s", fmt.Sprintf("File %d.", i)))
+			if err == nil {
+				atomic.AddInt32(&written, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), written)
+
+	// A write too quick to change the mod time still changes the version.
+	mtime := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	rootfs.Chtimes("/file3", mtime, mtime)
+	fi, _ := rootfs.Stat("/file3")
+	old := fileVersion(fi)
+	afero.WriteFile(rootfs, "/file3", []byte(`File 9.`), 0666)
+	rootfs.Chtimes("/file3", mtime, mtime)
+	fi, _ = rootfs.Stat("/file3")
+	require.Equal(t, old, fileVersion(fi))
+	fs.newVersion("/file3", old)
+	fi, _ = rootfs.Stat("/file3")
+	require.NotEqual(t, old, fileVersion(fi))
+}
+
+func TestUpload(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	srv := httptest.NewServer(UploadHandler(handler.GraphQL(NewExecutableSchema(Config{
+		Resolvers: &Resolver{
+			RootFS: FS{Fs: rootfs},
+		},
+	}))))
+	defer srv.Close()
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	mw.WriteField("operations", `{"query": "mutation($a: Upload!, $b: Upload!) { a: upload(path: \"/a\", file: $a) { s } b: upload(path: \"/b\", file: $b) { s } }", "variables": {"a": null, "b": null}}`)
+	mw.WriteField("map", `{"0": ["variables.b"], "1": ["variables.a"]}`)
+	fw, _ := mw.CreateFormFile("0", "b.txt")
+	fw.Write([]byte("Upload B."))
+	fw, _ = mw.CreateFormFile("1", "a.txt")
+	fw.Write([]byte("Upload A."))
+	mw.Close()
+
+	resp, err := http.Post(srv.URL, mw.FormDataContentType(), body)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	rbody, _ := ioutil.ReadAll(resp.Body)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(rbody))
+	require.NotContains(t, string(rbody), "errors")
+
+	// a was read first, so b had to be skipped over and spooled.
+	data, _ := afero.ReadFile(rootfs, "/a")
+	require.Equal(t, "Upload A.", string(data))
+	data, _ = afero.ReadFile(rootfs, "/b")
+	require.Equal(t, "Upload B.", string(data))
+}
+
+func TestRaw(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	afero.WriteFile(rootfs, "/dir/file one.txt", []byte(`0123456789`), 0666)
+	fs := FS{Fs: rootfs, RawURL: "/raw"}
+	c := newTestClient(t, fs)
+
+	var resp struct {
+		File struct {
+			DownloadURL string `json:"downloadURL"`
+			Version     string `json:"version"`
+		} `json:"file"`
+	}
+	c.MustPost(`query { file(path: "/dir/file one.txt") { ... on RegularFile { downloadURL version } } }`, &resp)
+	require.Equal(t, "/raw/dir/file%20one.txt", resp.File.DownloadURL)
+
+	srv := httptest.NewServer(http.StripPrefix("/raw", RawHandler(fs)))
+	defer srv.Close()
+	req, _ := http.NewRequest("GET", srv.URL+resp.File.DownloadURL, nil)
+	req.Header.Set("Range", "bytes=2-5")
+	hresp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	body, _ := ioutil.ReadAll(hresp.Body)
+	hresp.Body.Close()
+	require.Equal(t, http.StatusPartialContent, hresp.StatusCode)
+	require.Equal(t, "2345", string(body))
+	require.Equal(t, "text/plain; charset=utf-8", hresp.Header.Get("Content-Type"))
+	require.Equal(t, `"`+resp.File.Version+`"`, hresp.Header.Get("ETag"))
+
+	req.Header.Del("Range")
+	req.Header.Set("If-None-Match", hresp.Header.Get("ETag"))
+	hresp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	hresp.Body.Close()
+	require.Equal(t, http.StatusNotModified, hresp.StatusCode)
+
+	hresp, err = http.Get(srv.URL + "/raw/dir")
+	require.NoError(t, err)
+	hresp.Body.Close()
+	require.Equal(t, http.StatusNotFound, hresp.StatusCode)
+}
+
+func TestRawUpload(t *testing.T) {
+	rootfs := afero.NewMemMapFs()
+	rootfs.Mkdir("/dir", 0777)
+	srv := httptest.NewServer(RawHandler(FS{Fs: rootfs}))
+	defer srv.Close()
+	do := func(method, url, body string) (*http.Response, rawUploadStatus) {
+		req, _ := http.NewRequest(method, srv.URL+url, strings.NewReader(body))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var status rawUploadStatus
+		json.NewDecoder(resp.Body).Decode(&status)
+		return resp, status
+	}
+
+	resp, _ := do("PUT", "/dir/whole", "Whole file.")
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	data, _ := afero.ReadFile(rootfs, "/dir/whole")
+	require.Equal(t, "Whole file.", string(data))
+
+	resp, status := do("POST", "/dir/chunked?uploads", "")
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	id := status.Upload
+	require.NotEmpty(t, id)
+	_, status = do("PUT", "/dir/chunked?upload="+id+"&offset=0", "Chunk one, ")
+	require.Equal(t, int64(11), status.Offset)
+	// Resending from a stale offset reports where to continue.
+	resp, status = do("PUT", "/dir/chunked?upload="+id+"&offset=0", "Chunk one, ")
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	require.Equal(t, int64(11), status.Offset)
+	_, status = do("GET", "/dir/chunked?upload="+id, "")
+	require.Equal(t, int64(11), status.Offset)
+	do("PUT", "/dir/chunked?upload="+id+"&offset=11", "chunk two.")
+
+	resp, _ = do("POST", "/dir/chunked?upload="+id+"&hash=0000", "")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	sum := sha256.Sum256([]byte("Chunk one, chunk two."))
+	resp, status = do("POST", "/dir/chunked?upload="+id+"&hash="+hex.EncodeToString(sum[:]), "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEmpty(t, status.Version)
+	data, _ = afero.ReadFile(rootfs, "/dir/chunked")
+	require.Equal(t, "Chunk one, chunk two.", string(data))
+	f, _ := rootfs.Open("/dir")
+	names, _ := f.Readdirnames(-1)
+	f.Close()
+	sort.Strings(names)
+	require.Equal(t, []string{"chunked", "whole"}, names, "no temp files left")
+
+	// Of chunks sent at once from the same offset, only one is appended.
+	_, status = do("POST", "/dir/raced?uploads", "")
+	id = status.Upload
+	var wg sync.WaitGroup
+	var conflicts int32
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, _ := do("PUT", "/dir/raced?upload="+id+"&offset=0", "Chunk.")
+			if resp.StatusCode == http.StatusConflict {
+				atomic.AddInt32(&conflicts, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(7), conflicts)
+	_, status = do("GET", "/dir/raced?upload="+id, "")
+	require.Equal(t, int64(6), status.Offset)
+
+	// An abandoned session is removed when another upload begins.
+	_, status = do("POST", "/dir/old?uploads", "")
+	spath, _ := uploadSessionPath("/dir/old", status.Upload)
+	old := time.Now().Add(-uploadSessionExpiry - time.Minute)
+	rootfs.Chtimes(spath, old, old)
+	_, status = do("POST", "/dir/new?uploads", "")
+	_, err := rootfs.Stat(spath)
+	require.True(t, os.IsNotExist(err), "expired session removed")
+	resp, _ = do("DELETE", "/dir/new?upload="+status.Upload, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestWatch(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "fsgraph-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+	defer func(interval time.Duration) { watchPollInterval = interval }(watchPollInterval)
+	watchPollInterval = 20 * time.Millisecond
+
+	next := func(ch <-chan FileEvent) FileEvent {
+		select {
+		case ev := <-ch:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+			return FileEvent{}
+		}
+	}
+	for _, rootfs := range []afero.Fs{NewOsBasePathFs(tempdir), afero.NewMemMapFs()} {
+		rootfs.Mkdir("/dir", 0777)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, err := FS{Fs: rootfs}.watch(ctx, "/dir", true)
+		require.NoError(t, err)
+
+		rootfs.Mkdir("/dir/sub", 0777)
+		ev := next(ch)
+		require.Equal(t, FileEventTypeCreated, ev.Type)
+		require.Equal(t, "/dir/sub", ev.Path)
+		require.IsType(t, Dir{}, ev.file)
+
+		afero.WriteFile(rootfs, "/dir/sub/file1", []byte(`File one.`), 0666)
+		ev = next(ch)
+		require.Equal(t, FileEventTypeCreated, ev.Type)
+		require.Equal(t, "/dir/sub/file1", ev.Path)
+
+		fpath := "/dir/sub/file1"
+		if _, ok := rootfs.(OsPather); ok {
+			// Only inotify can tell a rename from a remove and create.
+			fpath = "/dir/sub/file2"
+			rootfs.Rename("/dir/sub/file1", fpath)
+			for ev.Type != FileEventTypeRenamed {
+				ev = next(ch) // Skips any modified events from the write.
+			}
+			require.Equal(t, fpath, ev.Path)
+			require.Equal(t, "/dir/sub/file1", *ev.OldPath)
+		}
+
+		rootfs.Remove(fpath)
+		for ev.Type != FileEventTypeRemoved {
+			ev = next(ch)
+		}
+		require.Equal(t, fpath, ev.Path)
+		require.Nil(t, ev.file)
+
+		cancel()
+		for range ch {
+		}
+		rootfs.RemoveAll("/dir")
+	}
+}
+
+func TestFollow(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "fsgraph-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+	rootfs := NewOsBasePathFs(tempdir)
+	afero.WriteFile(rootfs, "/log", []byte("one\n"), 0666)
+	defer func(interval time.Duration) { followPollInterval = interval }(followPollInterval)
+	followPollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := FS{Fs: rootfs}.follow(ctx, "/log", false, EncodingAuto)
+	require.NoError(t, err)
+	next := func() FollowEvent {
+		select {
+		case ev := <-ch:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+			return FollowEvent{}
+		}
+	}
+
+	ev := next()
+	require.Equal(t, FollowEvent{Type: FollowEventTypeData, Data: "one\n", Encoding: EncodingUtf8, Offset: 0, Next: 4}, ev)
+
+	// The partial UTF-8 sequence is held until the rest is written.
+	f, _ := rootfs.OpenFile("/log", os.O_WRONLY|os.O_APPEND, 0666)
+	f.Write([]byte("two \xe2\x82"))
+	ev = next()
+	require.Equal(t, "two ", ev.Data)
+	require.Equal(t, Int64(8), ev.Next)
+	f.Write([]byte("\xac\n"))
+	f.Close()
+	ev = next()
+	require.Equal(t, "€\n", ev.Data)
+	require.Equal(t, Int64(8), ev.Offset)
+
+	afero.WriteFile(rootfs, "/log", []byte("x"), 0666)
+	require.Equal(t, FollowEventTypeTruncated, next().Type)
+	require.Equal(t, "x", next().Data)
+
+	rootfs.Rename("/log", "/log.1")
+	afero.WriteFile(rootfs, "/log", []byte("new\n"), 0666)
+	require.Equal(t, FollowEventTypeRotated, next().Type)
+	ev = next()
+	require.Equal(t, "new\n", ev.Data)
+	require.Equal(t, Int64(0), ev.Offset)
+}
+
+func TestAuth(t *testing.T) {
+	require.Equal(t, "$apr1$abcdefgh$h9FWgUz3n9YxylKLlR5SQ/", apr1("secret", "abcdefgh"))
+
+	tempdir, err := ioutil.TempDir("", "fsgraph-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+	tokensfile := filepath.Join(tempdir, "tokens")
+	ioutil.WriteFile(tokensfile, []byte("# CI tokens\ntok123 ci\n\ntok456\n"), 0600)
+	htpasswdfile := filepath.Join(tempdir, "htpasswd")
+	ioutil.WriteFile(htpasswdfile, []byte("alice:$apr1$abcdefgh$h9FWgUz3n9YxylKLlR5SQ/\n"+
+		"carol:$2y$04$fEOkvSkCk1J.FPkaJeUTbex9ZJ9xTC89YD61PfEOliymDTUR0vDbG\n"), 0600)
+	tokens, err := LoadBearerTokens(tokensfile)
+	require.NoError(t, err)
+	htpasswd, err := LoadHtpasswd(htpasswdfile)
+	require.NoError(t, err)
+
+	custom := AuthenticatorFunc(func(r *http.Request) (*Identity, error) {
+		if r.Header.Get("X-Test-User") != "" {
+			return &Identity{Name: r.Header.Get("X-Test-User")}, nil
+		}
+		return nil, nil
+	})
+	srv := httptest.NewServer(AuthHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(GetIdentity(r.Context()).Name))
+	}), tokens, htpasswd, custom))
+	defer srv.Close()
+	get := func(setup func(req *http.Request)) (int, string) {
+		req, _ := http.NewRequest("GET", srv.URL+"/query", nil)
+		setup(req)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+
+	code, body := get(func(req *http.Request) { req.Header.Set("Authorization", "Bearer tok123") })
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ci", body)
+	_, body = get(func(req *http.Request) { req.URL.RawQuery = "access_token=tok456" })
+	require.Equal(t, "token2", body)
+	_, body = get(func(req *http.Request) { req.SetBasicAuth("alice", "secret") })
+	require.Equal(t, "alice", body)
+	_, body = get(func(req *http.Request) { req.SetBasicAuth("carol", "hunter2") })
+	require.Equal(t, "carol", body)
+	_, body = get(func(req *http.Request) { req.Header.Set("X-Test-User", "bob") })
+	require.Equal(t, "bob", body)
+
+	code, _ = get(func(req *http.Request) { req.SetBasicAuth("alice", "wrong") })
+	require.Equal(t, http.StatusUnauthorized, code)
+	code, _ = get(func(req *http.Request) { req.SetBasicAuth("carol", "wrong") })
+	require.Equal(t, http.StatusUnauthorized, code)
+	code, _ = get(func(req *http.Request) { req.Header.Set("Authorization", "Bearer nope") })
+	require.Equal(t, http.StatusUnauthorized, code)
+}
+
+func TestAccessRules(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "fsgraph-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+	rulesfile := filepath.Join(tempdir, "rules")
+	ioutil.WriteFile(rulesfile, []byte("ci read /logs/**\nci write /uploads/**\n* read /public/*\n"), 0600)
+	access, err := LoadAccessRules(rulesfile)
+	require.NoError(t, err)
+
+	rootdir := filepath.Join(tempdir, "root")
+	os.Mkdir(rootdir, 0777)
+	rootfs := NewOsBasePathFs(rootdir)
+	for _, dir := range []string{"/logs", "/secret", "/public", "/uploads"} {
+		rootfs.Mkdir(dir, 0777)
+	}
+	afero.WriteFile(rootfs, "/logs/build.log", []byte("ok"), 0666)
+	afero.WriteFile(rootfs, "/secret/key", []byte("hunter2"), 0666)
+	afero.WriteFile(rootfs, "/public/readme", []byte("hi"), 0666)
+	afero.WriteFile(rootfs, "/top.txt", []byte("top"), 0666)
+	os.Symlink("../secret", filepath.Join(rootdir, "uploads", "old"))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithIdentity(r.Context(), &Identity{Name: "ci"})
+		handler.GraphQL(NewExecutableSchema(Config{
+			Resolvers: &Resolver{
+				RootFS: FS{Fs: rootfs, Access: access},
+			},
+		})).ServeHTTP(w, r.WithContext(ctx))
+	}))
+	defer srv.Close()
+	c := client.New(srv.URL)
+
+	var resp struct {
+		Root struct {
+			Children []struct {
+				Name string `json:"name"`
+			} `json:"children"`
+		} `json:"root"`
+	}
+	c.MustPost(`query { root { children { name } } }`, &resp)
+	var names []string
+	for _, child := range resp.Root.Children {
+		names = append(names, child.Name)
+	}
+	sort.Strings(names)
+	require.Equal(t, []string{"logs", "public", "uploads"}, names)
+
+	var fresp struct {
+		File struct {
+			Contents struct {
+				Data string `json:"data"`
+			} `json:"contents"`
+		} `json:"file"`
+	}
+	c.MustPost(`query { file(path: "/logs/build.log") { ... on RegularFile { contents { data } } } }`, &fresp)
+	require.Equal(t, "ok", fresp.File.Contents.Data)
+	err = c.Post(`query { file(path: "/secret/key") { ... on RegularFile { contents { data } } } }`, &fresp)
+	require.Error(t, err)
+
+	var wresp struct {
+		Write struct {
+			S string `json:"s"`
+		} `json:"write"`
+	}
+	c.MustPost(`mutation { write(path: "/uploads/new", contents: "new") { s } }`, &wresp)
+	err = c.Post(`mutation { write(path: "/logs/build.log", contents: "bad") { s } }`, &wresp)
+	require.Error(t, err)
+	err = c.Post(`mutation { write(path: "/top.txt", contents: "bad") { s } }`, &wresp)
+	require.Error(t, err)
+	data, _ := afero.ReadFile(rootfs, "/logs/build.log")
+	require.Equal(t, "ok", string(data))
+
+	var gresp struct {
+		Grep struct {
+			MatchCount int `json:"matchCount"`
+		} `json:"grep"`
+	}
+	c.MustPost(`query { grep(pattern: "hunter") { matchCount } }`, &gresp)
+	require.Equal(t, 0, gresp.Grep.MatchCount)
+
+	var nresp struct {
+		Nodes []*struct {
+			ID string `json:"id"`
+		} `json:"nodes"`
+	}
+	c.MustPost(`query($ids: [ID!]!) { nodes(ids: $ids) { id } }`, &nresp,
+		client.Var("ids", []string{FS{}.genID("/secret/key"), FS{}.genID("/logs/build.log")}))
+	require.Equal(t, 2, len(nresp.Nodes))
+	require.Nil(t, nresp.Nodes[0], "denied node is null")
+	require.NotNil(t, nresp.Nodes[1])
+
+	// A file renamed from a denied path is reported as created.
+	w := &watcher{
+		ctx: context.Background(),
+		fs:  FS{Fs: rootfs, Access: access}.forContext(WithIdentity(context.Background(), &Identity{Name: "ci"})),
+		ch:  make(chan FileEvent, 1),
+	}
+	oldPath := "/secret/key"
+	w.emit(FileEventTypeRenamed, "/uploads/new", &oldPath)
+	ev := <-w.ch
+	require.Equal(t, FileEventTypeCreated, ev.Type)
+	require.Nil(t, ev.OldPath)
+
+	// Symlinks can't lead to what isn't allowed.
+	err = c.Post(`query { file(path: "/uploads/old/key") { ... on RegularFile { contents { data } } } }`, &fresp)
+	require.Error(t, err, "read through an existing symlink")
+	var sresp struct {
+		Symlink struct {
+			S string `json:"s"`
+		} `json:"symlink"`
+	}
+	err = c.Post(`mutation { symlink(target: "/secret", path: "/uploads/s") { s } }`, &sresp)
+	require.Error(t, err, "symlink to a denied target")
+	err = c.Post(`mutation { symlink(target: "../secret", path: "/uploads/s") { s } }`, &sresp)
+	require.Error(t, err, "relative symlink to a denied target")
+	c.MustPost(`mutation { symlink(target: "../logs/build.log", path: "/uploads/log") { s } }`, &sresp)
+	c.MustPost(`query { file(path: "/uploads/log") { ... on RegularFile { contents { data } } } }`, &fresp)
+	require.Equal(t, "ok", fresp.File.Contents.Data)
+	err = c.Post(`mutation { write(path: "/uploads/log", contents: "bad") { s } }`, &wresp)
+	require.Error(t, err, "write through a symlink to a read-only file")
+	var lresp struct {
+		Link struct {
+			S string `json:"s"`
+		} `json:"link"`
+	}
+	err = c.Post(`mutation { link(existingPath: "/logs/build.log", newPath: "/uploads/hard") { s } }`, &lresp)
+	require.Error(t, err, "hard link to a read-only file")
+	data, _ = afero.ReadFile(rootfs, "/logs/build.log")
+	require.Equal(t, "ok", string(data))
+}
@@ -31,12 +31,19 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	CopyResult() CopyResultResolver
+	Device() DeviceResolver
 	Dir() DirResolver
+	FileEvent() FileEventResolver
 	FileResult() FileResultResolver
-	Internal_OtherFile() Internal_OtherFileResolver
+	IrregularFile() IrregularFileResolver
 	Mutation() MutationResolver
+	NamedPipe() NamedPipeResolver
 	Query() QueryResolver
 	RegularFile() RegularFileResolver
+	Socket() SocketResolver
+	Subscription() SubscriptionResolver
+	Symlink() SymlinkResolver
 }
 
 type DirectiveRoot struct {
@@ -44,16 +51,46 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	CopyResult struct {
+		S       func(childComplexity int) int
+		Warning func(childComplexity int) int
+		File    func(childComplexity int) int
+		Files   func(childComplexity int) int
+		Bytes   func(childComplexity int) int
+	}
+
+	Device struct {
+		Id      func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Path    func(childComplexity int) int
+		Size    func(childComplexity int) int
+		Mode    func(childComplexity int) int
+		ModTime func(childComplexity int) int
+		Version func(childComplexity int) int
+		Parent  func(childComplexity int) int
+		Major   func(childComplexity int) int
+		Minor   func(childComplexity int) int
+	}
+
 	Dir struct {
-		Id       func(childComplexity int) int
-		Name     func(childComplexity int) int
-		Path     func(childComplexity int) int
-		Size     func(childComplexity int) int
-		Mode     func(childComplexity int) int
-		ModTime  func(childComplexity int) int
-		Parent   func(childComplexity int) int
-		Children func(childComplexity int, first int) int
-		File     func(childComplexity int, path string) int
+		Id                 func(childComplexity int) int
+		Name               func(childComplexity int) int
+		Path               func(childComplexity int) int
+		Size               func(childComplexity int) int
+		Mode               func(childComplexity int) int
+		ModTime            func(childComplexity int) int
+		Version            func(childComplexity int) int
+		Parent             func(childComplexity int) int
+		Children           func(childComplexity int, first int, orderBy *FileOrder, filter *FileFilter) int
+		ChildrenConnection func(childComplexity int, first *int, after *string, last *int, before *string) int
+		File               func(childComplexity int, path string, followSymlinks bool) int
+		Walk               func(childComplexity int, maxDepth int, filter *FileFilter, first *int, after *string) int
+	}
+
+	FileConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
 	}
 
 	FileContents struct {
@@ -63,6 +100,24 @@ type ComplexityRoot struct {
 		Warning  func(childComplexity int) int
 	}
 
+	FileEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	FileEvent struct {
+		Type    func(childComplexity int) int
+		Path    func(childComplexity int) int
+		OldPath func(childComplexity int) int
+		File    func(childComplexity int) int
+	}
+
+	FileLines struct {
+		Lines      func(childComplexity int) int
+		TotalLines func(childComplexity int) int
+		Warning    func(childComplexity int) int
+	}
+
 	FileMode struct {
 		Type   func(childComplexity int) int
 		Perm   func(childComplexity int) int
@@ -75,23 +130,80 @@ type ComplexityRoot struct {
 		File    func(childComplexity int) int
 	}
 
-	InternalOtherFile struct {
+	FollowEvent struct {
+		Type     func(childComplexity int) int
+		Data     func(childComplexity int) int
+		Encoding func(childComplexity int) int
+		Offset   func(childComplexity int) int
+		Next     func(childComplexity int) int
+		Warning  func(childComplexity int) int
+	}
+
+	GrepFile struct {
+		File    func(childComplexity int) int
+		Matches func(childComplexity int) int
+	}
+
+	GrepMatch struct {
+		Line   func(childComplexity int) int
+		Offset func(childComplexity int) int
+		Column func(childComplexity int) int
+		Length func(childComplexity int) int
+		Text   func(childComplexity int) int
+		Before func(childComplexity int) int
+		After  func(childComplexity int) int
+	}
+
+	GrepResult struct {
+		Files      func(childComplexity int) int
+		MatchCount func(childComplexity int) int
+		Truncated  func(childComplexity int) int
+	}
+
+	IrregularFile struct {
 		Id      func(childComplexity int) int
 		Name    func(childComplexity int) int
 		Path    func(childComplexity int) int
 		Size    func(childComplexity int) int
 		Mode    func(childComplexity int) int
 		ModTime func(childComplexity int) int
+		Version func(childComplexity int) int
 		Parent  func(childComplexity int) int
 	}
 
+	Line struct {
+		Number func(childComplexity int) int
+		Text   func(childComplexity int) int
+	}
+
 	Mutation struct {
-		Remove   func(childComplexity int, path string) int
-		Rename   func(childComplexity int, path string, newName string) int
-		Chmod    func(childComplexity int, path string, mode int) int
-		Write    func(childComplexity int, path string, contents string, open []FileOpen, encoding Encoding) int
-		Mkdir    func(childComplexity int, path string) int
-		MkdirAll func(childComplexity int, path string) int
+		Remove    func(childComplexity int, path string, ifMatch *string, ifUnmodifiedSince *string) int
+		RemoveAll func(childComplexity int, path string, dryRun bool) int
+		Rename    func(childComplexity int, path string, newName string, ifMatch *string, ifUnmodifiedSince *string, ifNotExists bool) int
+		Chmod     func(childComplexity int, path string, mode int, ifMatch *string, ifUnmodifiedSince *string) int
+		Write     func(childComplexity int, path string, contents string, open []FileOpen, encoding Encoding, atomic bool, ifMatch *string, ifUnmodifiedSince *string, ifNotExists bool) int
+		Copy      func(childComplexity int, path string, destPath string, recursive bool, overwrite bool, preserveMode bool, preserveTimes bool) int
+		Move      func(childComplexity int, path string, destPath string, overwrite bool) int
+		Symlink   func(childComplexity int, target string, path string) int
+		Link      func(childComplexity int, existingPath string, newPath string) int
+		Chtimes   func(childComplexity int, path string, modTime string, accessTime *string) int
+		Touch     func(childComplexity int, path string, createIfMissing bool) int
+		WriteAt   func(childComplexity int, path string, offset Int64, contents string, encoding Encoding) int
+		Truncate  func(childComplexity int, path string, size Int64) int
+		Upload    func(childComplexity int, path string, file Upload, open []FileOpen) int
+		Mkdir     func(childComplexity int, path string) int
+		MkdirAll  func(childComplexity int, path string) int
+	}
+
+	NamedPipe struct {
+		Id      func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Path    func(childComplexity int) int
+		Size    func(childComplexity int) int
+		Mode    func(childComplexity int) int
+		ModTime func(childComplexity int) int
+		Version func(childComplexity int) int
+		Parent  func(childComplexity int) int
 	}
 
 	Okresult struct {
@@ -99,51 +211,159 @@ type ComplexityRoot struct {
 		Warning func(childComplexity int) int
 	}
 
+	PageInfo struct {
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+		EndCursor       func(childComplexity int) int
+	}
+
 	Query struct {
-		Root func(childComplexity int) int
-		Cd   func(childComplexity int, path string) int
-		File func(childComplexity int, path string) int
+		Root  func(childComplexity int) int
+		Cd    func(childComplexity int, path string) int
+		File  func(childComplexity int, path string, followSymlinks bool) int
+		Node  func(childComplexity int, id string) int
+		Nodes func(childComplexity int, ids []string) int
+		Find  func(childComplexity int, path string, maxDepth int, filter *FileFilter, first *int, after *string) int
+		Grep  func(childComplexity int, pattern string, regex bool, caseInsensitive bool, path string, include []string, maxMatches int, contextLines int) int
 	}
 
 	RegularFile struct {
+		Id          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Path        func(childComplexity int) int
+		Size        func(childComplexity int) int
+		Mode        func(childComplexity int) int
+		ModTime     func(childComplexity int) int
+		Version     func(childComplexity int) int
+		Parent      func(childComplexity int) int
+		Contents    func(childComplexity int, encoding Encoding, maxReadBytes Int64, seek Int64) int
+		Hash        func(childComplexity int, algorithm HashAlgorithm) int
+		MimeType    func(childComplexity int) int
+		IsText      func(childComplexity int) int
+		Lines       func(childComplexity int, from int, count int, fromEnd bool) int
+		DownloadUrl func(childComplexity int) int
+	}
+
+	RemoveAllResult struct {
+		S       func(childComplexity int) int
+		Warning func(childComplexity int) int
+		Paths   func(childComplexity int) int
+		Bytes   func(childComplexity int) int
+	}
+
+	Socket struct {
+		Id      func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Path    func(childComplexity int) int
+		Size    func(childComplexity int) int
+		Mode    func(childComplexity int) int
+		ModTime func(childComplexity int) int
+		Version func(childComplexity int) int
+		Parent  func(childComplexity int) int
+	}
+
+	Subscription struct {
+		Watch  func(childComplexity int, path string, recursive bool) int
+		Follow func(childComplexity int, path string, fromEnd bool, encoding Encoding) int
+	}
+
+	Symlink struct {
 		Id       func(childComplexity int) int
 		Name     func(childComplexity int) int
 		Path     func(childComplexity int) int
 		Size     func(childComplexity int) int
 		Mode     func(childComplexity int) int
 		ModTime  func(childComplexity int) int
+		Version  func(childComplexity int) int
 		Parent   func(childComplexity int) int
-		Contents func(childComplexity int, encoding Encoding, maxReadBytes Int64, seek Int64) int
+		Target   func(childComplexity int) int
+		Resolved func(childComplexity int) int
+	}
+
+	WalkConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	WalkEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+		Depth  func(childComplexity int) int
 	}
 }
 
+type CopyResultResolver interface {
+	File(ctx context.Context, obj *CopyResult) (File, error)
+}
+type DeviceResolver interface {
+	Parent(ctx context.Context, obj *Device) (File, error)
+}
 type DirResolver interface {
 	Parent(ctx context.Context, obj *Dir) (File, error)
-	Children(ctx context.Context, obj *Dir, first int) ([]File, error)
-	File(ctx context.Context, obj *Dir, path string) (File, error)
+	Children(ctx context.Context, obj *Dir, first int, orderBy *FileOrder, filter *FileFilter) ([]File, error)
+	ChildrenConnection(ctx context.Context, obj *Dir, first *int, after *string, last *int, before *string) (FileConnection, error)
+	File(ctx context.Context, obj *Dir, path string, followSymlinks bool) (File, error)
+	Walk(ctx context.Context, obj *Dir, maxDepth int, filter *FileFilter, first *int, after *string) (WalkConnection, error)
+}
+type FileEventResolver interface {
+	File(ctx context.Context, obj *FileEvent) (File, error)
 }
 type FileResultResolver interface {
 	File(ctx context.Context, obj *FileResult) (File, error)
 }
-type Internal_OtherFileResolver interface {
-	Parent(ctx context.Context, obj *Internal_OtherFile) (File, error)
+type IrregularFileResolver interface {
+	Parent(ctx context.Context, obj *IrregularFile) (File, error)
 }
 type MutationResolver interface {
-	Remove(ctx context.Context, path string) (OKResult, error)
-	Rename(ctx context.Context, path string, newName string) (FileResult, error)
-	Chmod(ctx context.Context, path string, mode int) (FileResult, error)
-	Write(ctx context.Context, path string, contents string, open []FileOpen, encoding Encoding) (FileResult, error)
+	Remove(ctx context.Context, path string, ifMatch *string, ifUnmodifiedSince *string) (OKResult, error)
+	RemoveAll(ctx context.Context, path string, dryRun bool) (RemoveAllResult, error)
+	Rename(ctx context.Context, path string, newName string, ifMatch *string, ifUnmodifiedSince *string, ifNotExists bool) (FileResult, error)
+	Chmod(ctx context.Context, path string, mode int, ifMatch *string, ifUnmodifiedSince *string) (FileResult, error)
+	Write(ctx context.Context, path string, contents string, open []FileOpen, encoding Encoding, atomic bool, ifMatch *string, ifUnmodifiedSince *string, ifNotExists bool) (FileResult, error)
+	Copy(ctx context.Context, path string, destPath string, recursive bool, overwrite bool, preserveMode bool, preserveTimes bool) (CopyResult, error)
+	Move(ctx context.Context, path string, destPath string, overwrite bool) (FileResult, error)
+	Symlink(ctx context.Context, target string, path string) (FileResult, error)
+	Link(ctx context.Context, existingPath string, newPath string) (FileResult, error)
+	Chtimes(ctx context.Context, path string, modTime string, accessTime *string) (FileResult, error)
+	Touch(ctx context.Context, path string, createIfMissing bool) (FileResult, error)
+	WriteAt(ctx context.Context, path string, offset Int64, contents string, encoding Encoding) (FileResult, error)
+	Truncate(ctx context.Context, path string, size Int64) (FileResult, error)
+	Upload(ctx context.Context, path string, file Upload, open []FileOpen) (FileResult, error)
 	Mkdir(ctx context.Context, path string) (FileResult, error)
 	MkdirAll(ctx context.Context, path string) (FileResult, error)
 }
+type NamedPipeResolver interface {
+	Parent(ctx context.Context, obj *NamedPipe) (File, error)
+}
 type QueryResolver interface {
 	Root(ctx context.Context) (Dir, error)
 	Cd(ctx context.Context, path string) (*Dir, error)
-	File(ctx context.Context, path string) (File, error)
+	File(ctx context.Context, path string, followSymlinks bool) (File, error)
+	Node(ctx context.Context, id string) (Node, error)
+	Nodes(ctx context.Context, ids []string) ([]Node, error)
+	Find(ctx context.Context, path string, maxDepth int, filter *FileFilter, first *int, after *string) (*WalkConnection, error)
+	Grep(ctx context.Context, pattern string, regex bool, caseInsensitive bool, path string, include []string, maxMatches int, contextLines int) (GrepResult, error)
 }
 type RegularFileResolver interface {
 	Parent(ctx context.Context, obj *RegularFile) (File, error)
 	Contents(ctx context.Context, obj *RegularFile, encoding Encoding, maxReadBytes Int64, seek Int64) (FileContents, error)
+	Hash(ctx context.Context, obj *RegularFile, algorithm HashAlgorithm) (string, error)
+	MimeType(ctx context.Context, obj *RegularFile) (*string, error)
+	IsText(ctx context.Context, obj *RegularFile) (*bool, error)
+	Lines(ctx context.Context, obj *RegularFile, from int, count int, fromEnd bool) (FileLines, error)
+}
+type SocketResolver interface {
+	Parent(ctx context.Context, obj *Socket) (File, error)
+}
+type SubscriptionResolver interface {
+	Watch(ctx context.Context, path string, recursive bool) (<-chan FileEvent, error)
+	Follow(ctx context.Context, path string, fromEnd bool, encoding Encoding) (<-chan FollowEvent, error)
+}
+type SymlinkResolver interface {
+	Parent(ctx context.Context, obj *Symlink) (File, error)
+	Target(ctx context.Context, obj *Symlink) (*string, error)
+	Resolved(ctx context.Context, obj *Symlink) (File, error)
 }
 
 func field_Dir_children_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
@@ -157,65 +377,101 @@ func field_Dir_children_args(rawArgs map[string]interface{}) (map[string]interfa
 		}
 	}
 	args["first"] = arg0
-	return args, nil
-
-}
+	var arg1 *FileOrder
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		var err error
+		var ptr1 FileOrder
+		if tmp != nil {
+			ptr1, err = UnmarshalFileOrder(tmp)
+			arg1 = &ptr1
+		}
 
-func field_Dir_file_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["path"]; ok {
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["orderBy"] = arg1
+	var arg2 *FileFilter
+	if tmp, ok := rawArgs["filter"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalString(tmp)
+		var ptr1 FileFilter
+		if tmp != nil {
+			ptr1, err = UnmarshalFileFilter(tmp)
+			arg2 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["path"] = arg0
+	args["filter"] = arg2
 	return args, nil
 
 }
 
-func field_Mutation_remove_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Dir_childrenConnection_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["path"]; ok {
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalString(tmp)
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg0 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["path"] = arg0
-	return args, nil
-
-}
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
 
-func field_Mutation_rename_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["path"]; ok {
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["last"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalString(tmp)
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg2 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["path"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["newName"]; ok {
+	args["last"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["before"]; ok {
 		var err error
-		arg1, err = graphql.UnmarshalString(tmp)
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg3 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["newName"] = arg1
+	args["before"] = arg3
 	return args, nil
 
 }
 
-func field_Mutation_chmod_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Dir_file_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["path"]; ok {
@@ -226,73 +482,77 @@ func field_Mutation_chmod_args(rawArgs map[string]interface{}) (map[string]inter
 		}
 	}
 	args["path"] = arg0
-	var arg1 int
-	if tmp, ok := rawArgs["mode"]; ok {
+	var arg1 bool
+	if tmp, ok := rawArgs["followSymlinks"]; ok {
 		var err error
-		arg1, err = graphql.UnmarshalInt(tmp)
+		arg1, err = graphql.UnmarshalBoolean(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["mode"] = arg1
+	args["followSymlinks"] = arg1
 	return args, nil
 
 }
 
-func field_Mutation_write_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Dir_walk_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["path"]; ok {
+	var arg0 int
+	if tmp, ok := rawArgs["maxDepth"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalString(tmp)
+		arg0, err = graphql.UnmarshalInt(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["path"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["contents"]; ok {
+	args["maxDepth"] = arg0
+	var arg1 *FileFilter
+	if tmp, ok := rawArgs["filter"]; ok {
 		var err error
-		arg1, err = graphql.UnmarshalString(tmp)
+		var ptr1 FileFilter
+		if tmp != nil {
+			ptr1, err = UnmarshalFileFilter(tmp)
+			arg1 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["contents"] = arg1
-	var arg2 []FileOpen
-	if tmp, ok := rawArgs["open"]; ok {
+	args["filter"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["first"]; ok {
 		var err error
-		var rawIf1 []interface{}
+		var ptr1 int
 		if tmp != nil {
-			if tmp1, ok := tmp.([]interface{}); ok {
-				rawIf1 = tmp1
-			} else {
-				rawIf1 = []interface{}{tmp}
-			}
-		}
-		arg2 = make([]FileOpen, len(rawIf1))
-		for idx1 := range rawIf1 {
-			err = (&arg2[idx1]).UnmarshalGQL(rawIf1[idx1])
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg2 = &ptr1
 		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["open"] = arg2
-	var arg3 Encoding
-	if tmp, ok := rawArgs["encoding"]; ok {
+	args["first"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["after"]; ok {
 		var err error
-		err = (&arg3).UnmarshalGQL(tmp)
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg3 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["encoding"] = arg3
+	args["after"] = arg3
 	return args, nil
 
 }
 
-func field_Mutation_mkdir_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_remove_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["path"]; ok {
@@ -303,26 +563,39 @@ func field_Mutation_mkdir_args(rawArgs map[string]interface{}) (map[string]inter
 		}
 	}
 	args["path"] = arg0
-	return args, nil
-
-}
+	var arg1 *string
+	if tmp, ok := rawArgs["ifMatch"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
 
-func field_Mutation_mkdirAll_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["path"]; ok {
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ifMatch"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["ifUnmodifiedSince"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalString(tmp)
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["path"] = arg0
+	args["ifUnmodifiedSince"] = arg2
 	return args, nil
 
 }
 
-func field_Query_cd_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_removeAll_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["path"]; ok {
@@ -333,11 +606,20 @@ func field_Query_cd_args(rawArgs map[string]interface{}) (map[string]interface{}
 		}
 	}
 	args["path"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["dryRun"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["dryRun"] = arg1
 	return args, nil
 
 }
 
-func field_Query_file_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_rename_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["path"]; ok {
@@ -348,537 +630,6961 @@ func field_Query_file_args(rawArgs map[string]interface{}) (map[string]interface
 		}
 	}
 	args["path"] = arg0
-	return args, nil
-
-}
-
-func field_Query___type_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
+	var arg1 string
+	if tmp, ok := rawArgs["newName"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalString(tmp)
+		arg1, err = graphql.UnmarshalString(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
-	return args, nil
-
-}
-
-func field_RegularFile_contents_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 Encoding
-	if tmp, ok := rawArgs["encoding"]; ok {
+	args["newName"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["ifMatch"]; ok {
 		var err error
-		err = (&arg0).UnmarshalGQL(tmp)
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["encoding"] = arg0
-	var arg1 Int64
-	if tmp, ok := rawArgs["maxReadBytes"]; ok {
+	args["ifMatch"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["ifUnmodifiedSince"]; ok {
 		var err error
-		err = (&arg1).UnmarshalGQL(tmp)
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg3 = &ptr1
+		}
+
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["maxReadBytes"] = arg1
-	var arg2 Int64
-	if tmp, ok := rawArgs["seek"]; ok {
+	args["ifUnmodifiedSince"] = arg3
+	var arg4 bool
+	if tmp, ok := rawArgs["ifNotExists"]; ok {
 		var err error
-		err = (&arg2).UnmarshalGQL(tmp)
+		arg4, err = graphql.UnmarshalBoolean(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["seek"] = arg2
+	args["ifNotExists"] = arg4
 	return args, nil
 
 }
 
-func field___Type_fields_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_chmod_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalBoolean(tmp)
+		arg0, err = graphql.UnmarshalString(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-
-}
-
-func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+	args["path"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["mode"]; ok {
 		var err error
-		arg0, err = graphql.UnmarshalBoolean(tmp)
+		arg1, err = graphql.UnmarshalInt(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["mode"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["ifMatch"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ifMatch"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["ifUnmodifiedSince"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg3 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ifUnmodifiedSince"] = arg3
 	return args, nil
 
 }
 
-func dir_scalarInfo_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func field_Mutation_write_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["baseType"]; ok {
+	if tmp, ok := rawArgs["path"]; ok {
 		var err error
 		arg0, err = graphql.UnmarshalString(tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["baseType"] = arg0
-	return args, nil
-
-}
-
-type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
-}
-
-func (e *executableSchema) Schema() *ast.Schema {
-	return parsedSchema
-}
-
-func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
-	switch typeName + "." + field {
-
-	case "Dir.id":
-		if e.complexity.Dir.Id == nil {
-			break
-		}
-
-		return e.complexity.Dir.Id(childComplexity), true
-
-	case "Dir.name":
-		if e.complexity.Dir.Name == nil {
-			break
+	args["path"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["contents"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Dir.Name(childComplexity), true
-
-	case "Dir.path":
-		if e.complexity.Dir.Path == nil {
-			break
+	}
+	args["contents"] = arg1
+	var arg2 []FileOpen
+	if tmp, ok := rawArgs["open"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
 		}
-
-		return e.complexity.Dir.Path(childComplexity), true
-
-	case "Dir.size":
-		if e.complexity.Dir.Size == nil {
-			break
+		arg2 = make([]FileOpen, len(rawIf1))
+		for idx1 := range rawIf1 {
+			err = (&arg2[idx1]).UnmarshalGQL(rawIf1[idx1])
 		}
-
-		return e.complexity.Dir.Size(childComplexity), true
-
-	case "Dir.mode":
-		if e.complexity.Dir.Mode == nil {
-			break
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Dir.Mode(childComplexity), true
-
-	case "Dir.modTime":
-		if e.complexity.Dir.ModTime == nil {
-			break
+	}
+	args["open"] = arg2
+	var arg3 Encoding
+	if tmp, ok := rawArgs["encoding"]; ok {
+		var err error
+		err = (&arg3).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Dir.ModTime(childComplexity), true
-
-	case "Dir.parent":
-		if e.complexity.Dir.Parent == nil {
-			break
+	}
+	args["encoding"] = arg3
+	var arg4 bool
+	if tmp, ok := rawArgs["atomic"]; ok {
+		var err error
+		arg4, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.Dir.Parent(childComplexity), true
-
-	case "Dir.children":
-		if e.complexity.Dir.Children == nil {
-			break
+	}
+	args["atomic"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["ifMatch"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg5 = &ptr1
 		}
 
-		args, err := field_Dir_children_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
-
-		return e.complexity.Dir.Children(childComplexity, args["first"].(int)), true
-
-	case "Dir.file":
-		if e.complexity.Dir.File == nil {
-			break
+	}
+	args["ifMatch"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["ifUnmodifiedSince"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg6 = &ptr1
 		}
 
-		args, err := field_Dir_file_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
-
-		return e.complexity.Dir.File(childComplexity, args["path"].(string)), true
-
-	case "FileContents.data":
-		if e.complexity.FileContents.Data == nil {
-			break
+	}
+	args["ifUnmodifiedSince"] = arg6
+	var arg7 bool
+	if tmp, ok := rawArgs["ifNotExists"]; ok {
+		var err error
+		arg7, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["ifNotExists"] = arg7
+	return args, nil
 
-		return e.complexity.FileContents.Data(childComplexity), true
+}
 
-	case "FileContents.next":
-		if e.complexity.FileContents.Next == nil {
-			break
+func field_Mutation_copy_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.FileContents.Next(childComplexity), true
-
-	case "FileContents.encoding":
-		if e.complexity.FileContents.Encoding == nil {
-			break
+	}
+	args["path"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["destPath"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.FileContents.Encoding(childComplexity), true
-
-	case "FileContents.warning":
-		if e.complexity.FileContents.Warning == nil {
-			break
+	}
+	args["destPath"] = arg1
+	var arg2 bool
+	if tmp, ok := rawArgs["recursive"]; ok {
+		var err error
+		arg2, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.FileContents.Warning(childComplexity), true
-
-	case "FileMode.type":
-		if e.complexity.FileMode.Type == nil {
-			break
+	}
+	args["recursive"] = arg2
+	var arg3 bool
+	if tmp, ok := rawArgs["overwrite"]; ok {
+		var err error
+		arg3, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.FileMode.Type(childComplexity), true
-
-	case "FileMode.perm":
-		if e.complexity.FileMode.Perm == nil {
-			break
+	}
+	args["overwrite"] = arg3
+	var arg4 bool
+	if tmp, ok := rawArgs["preserveMode"]; ok {
+		var err error
+		arg4, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.FileMode.Perm(childComplexity), true
-
-	case "FileMode.sticky":
-		if e.complexity.FileMode.Sticky == nil {
-			break
+	}
+	args["preserveMode"] = arg4
+	var arg5 bool
+	if tmp, ok := rawArgs["preserveTimes"]; ok {
+		var err error
+		arg5, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["preserveTimes"] = arg5
+	return args, nil
 
-		return e.complexity.FileMode.Sticky(childComplexity), true
+}
 
-	case "FileResult.s":
-		if e.complexity.FileResult.S == nil {
-			break
+func field_Mutation_move_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["path"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["destPath"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["destPath"] = arg1
+	var arg2 bool
+	if tmp, ok := rawArgs["overwrite"]; ok {
+		var err error
+		arg2, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["overwrite"] = arg2
+	return args, nil
 
-		return e.complexity.FileResult.S(childComplexity), true
+}
 
-	case "FileResult.warning":
-		if e.complexity.FileResult.Warning == nil {
-			break
+func field_Mutation_symlink_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["target"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.FileResult.Warning(childComplexity), true
-
-	case "FileResult.file":
-		if e.complexity.FileResult.File == nil {
-			break
+	}
+	args["target"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["path"] = arg1
+	return args, nil
 
-		return e.complexity.FileResult.File(childComplexity), true
+}
 
-	case "Internal_OtherFile.id":
-		if e.complexity.InternalOtherFile.Id == nil {
-			break
+func field_Mutation_link_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["existingPath"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.InternalOtherFile.Id(childComplexity), true
-
-	case "Internal_OtherFile.name":
-		if e.complexity.InternalOtherFile.Name == nil {
-			break
+	}
+	args["existingPath"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["newPath"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["newPath"] = arg1
+	return args, nil
 
-		return e.complexity.InternalOtherFile.Name(childComplexity), true
+}
 
-	case "Internal_OtherFile.path":
-		if e.complexity.InternalOtherFile.Path == nil {
-			break
+func field_Mutation_chtimes_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.InternalOtherFile.Path(childComplexity), true
-
-	case "Internal_OtherFile.size":
-		if e.complexity.InternalOtherFile.Size == nil {
-			break
+	}
+	args["path"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["modTime"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		return e.complexity.InternalOtherFile.Size(childComplexity), true
-
-	case "Internal_OtherFile.mode":
-		if e.complexity.InternalOtherFile.Mode == nil {
-			break
+	}
+	args["modTime"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["accessTime"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg2 = &ptr1
 		}
 
-		return e.complexity.InternalOtherFile.Mode(childComplexity), true
-
-	case "Internal_OtherFile.modTime":
-		if e.complexity.InternalOtherFile.ModTime == nil {
-			break
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["accessTime"] = arg2
+	return args, nil
 
-		return e.complexity.InternalOtherFile.ModTime(childComplexity), true
+}
 
-	case "Internal_OtherFile.parent":
-		if e.complexity.InternalOtherFile.Parent == nil {
-			break
+func field_Mutation_touch_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["path"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["createIfMissing"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["createIfMissing"] = arg1
+	return args, nil
 
-		return e.complexity.InternalOtherFile.Parent(childComplexity), true
+}
 
-	case "Mutation.remove":
-		if e.complexity.Mutation.Remove == nil {
-			break
+func field_Mutation_writeAt_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		args, err := field_Mutation_remove_args(rawArgs)
+	}
+	args["path"] = arg0
+	var arg1 Int64
+	if tmp, ok := rawArgs["offset"]; ok {
+		var err error
+		err = (&arg1).UnmarshalGQL(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
-
-		return e.complexity.Mutation.Remove(childComplexity, args["path"].(string)), true
-
-	case "Mutation.rename":
-		if e.complexity.Mutation.Rename == nil {
-			break
+	}
+	args["offset"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["contents"]; ok {
+		var err error
+		arg2, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		args, err := field_Mutation_rename_args(rawArgs)
+	}
+	args["contents"] = arg2
+	var arg3 Encoding
+	if tmp, ok := rawArgs["encoding"]; ok {
+		var err error
+		err = (&arg3).UnmarshalGQL(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["encoding"] = arg3
+	return args, nil
 
-		return e.complexity.Mutation.Rename(childComplexity, args["path"].(string), args["newName"].(string)), true
+}
 
-	case "Mutation.chmod":
-		if e.complexity.Mutation.Chmod == nil {
-			break
+func field_Mutation_truncate_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		args, err := field_Mutation_chmod_args(rawArgs)
+	}
+	args["path"] = arg0
+	var arg1 Int64
+	if tmp, ok := rawArgs["size"]; ok {
+		var err error
+		err = (&arg1).UnmarshalGQL(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["size"] = arg1
+	return args, nil
 
-		return e.complexity.Mutation.Chmod(childComplexity, args["path"].(string), args["mode"].(int)), true
+}
 
-	case "Mutation.write":
-		if e.complexity.Mutation.Write == nil {
-			break
+func field_Mutation_upload_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
-
-		args, err := field_Mutation_write_args(rawArgs)
+	}
+	args["path"] = arg0
+	var arg1 Upload
+	if tmp, ok := rawArgs["file"]; ok {
+		var err error
+		err = (&arg1).UnmarshalGQL(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
-
-		return e.complexity.Mutation.Write(childComplexity, args["path"].(string), args["contents"].(string), args["open"].([]FileOpen), args["encoding"].(Encoding)), true
-
-	case "Mutation.mkdir":
-		if e.complexity.Mutation.Mkdir == nil {
-			break
+	}
+	args["file"] = arg1
+	var arg2 []FileOpen
+	if tmp, ok := rawArgs["open"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg2 = make([]FileOpen, len(rawIf1))
+		for idx1 := range rawIf1 {
+			err = (&arg2[idx1]).UnmarshalGQL(rawIf1[idx1])
 		}
-
-		args, err := field_Mutation_mkdir_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["open"] = arg2
+	return args, nil
 
-		return e.complexity.Mutation.Mkdir(childComplexity, args["path"].(string)), true
-
-	case "Mutation.mkdirAll":
-		if e.complexity.Mutation.MkdirAll == nil {
-			break
-		}
+}
 
-		args, err := field_Mutation_mkdirAll_args(rawArgs)
+func field_Mutation_mkdir_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["path"] = arg0
+	return args, nil
 
-		return e.complexity.Mutation.MkdirAll(childComplexity, args["path"].(string)), true
+}
 
-	case "OKResult.s":
-		if e.complexity.Okresult.S == nil {
-			break
+func field_Mutation_mkdirAll_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["path"] = arg0
+	return args, nil
 
-		return e.complexity.Okresult.S(childComplexity), true
+}
 
-	case "OKResult.warning":
-		if e.complexity.Okresult.Warning == nil {
-			break
+func field_Query_cd_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["path"] = arg0
+	return args, nil
 
-		return e.complexity.Okresult.Warning(childComplexity), true
+}
 
-	case "Query.root":
-		if e.complexity.Query.Root == nil {
-			break
+func field_Query_file_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["path"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["followSymlinks"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["followSymlinks"] = arg1
+	return args, nil
 
-		return e.complexity.Query.Root(childComplexity), true
+}
 
-	case "Query.cd":
-		if e.complexity.Query.Cd == nil {
-			break
+func field_Query_node_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalID(tmp)
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["id"] = arg0
+	return args, nil
 
-		args, err := field_Query_cd_args(rawArgs)
+}
+
+func field_Query_nodes_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg0 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg0[idx1], err = graphql.UnmarshalID(rawIf1[idx1])
+		}
 		if err != nil {
-			return 0, false
+			return nil, err
 		}
+	}
+	args["ids"] = arg0
+	return args, nil
 
-		return e.complexity.Query.Cd(childComplexity, args["path"].(string)), true
+}
 
-	case "Query.file":
-		if e.complexity.Query.File == nil {
-			break
+func field_Query_find_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["path"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["maxDepth"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxDepth"] = arg1
+	var arg2 *FileFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		var err error
+		var ptr1 FileFilter
+		if tmp != nil {
+			ptr1, err = UnmarshalFileFilter(tmp)
+			arg2 = &ptr1
 		}
 
-		args, err := field_Query_file_args(rawArgs)
 		if err != nil {
-			return 0, false
+			return nil, err
+		}
+	}
+	args["filter"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		var err error
+		var ptr1 int
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalInt(tmp)
+			arg3 = &ptr1
 		}
 
-		return e.complexity.Query.File(childComplexity, args["path"].(string)), true
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg4 = &ptr1
+		}
 
-	case "RegularFile.id":
-		if e.complexity.RegularFile.Id == nil {
-			break
+		if err != nil {
+			return nil, err
 		}
+	}
+	args["after"] = arg4
+	return args, nil
 
-		return e.complexity.RegularFile.Id(childComplexity), true
+}
 
-	case "RegularFile.name":
-		if e.complexity.RegularFile.Name == nil {
-			break
+func field_Query_grep_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["pattern"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pattern"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["regex"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["regex"] = arg1
+	var arg2 bool
+	if tmp, ok := rawArgs["caseInsensitive"]; ok {
+		var err error
+		arg2, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["caseInsensitive"] = arg2
+	var arg3 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg3, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["path"] = arg3
+	var arg4 []string
+	if tmp, ok := rawArgs["include"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg4 = make([]string, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg4[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["include"] = arg4
+	var arg5 int
+	if tmp, ok := rawArgs["maxMatches"]; ok {
+		var err error
+		arg5, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxMatches"] = arg5
+	var arg6 int
+	if tmp, ok := rawArgs["contextLines"]; ok {
+		var err error
+		arg6, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["contextLines"] = arg6
+	return args, nil
+
+}
+
+func field_Query___type_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+
+}
+
+func field_RegularFile_contents_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 Encoding
+	if tmp, ok := rawArgs["encoding"]; ok {
+		var err error
+		err = (&arg0).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["encoding"] = arg0
+	var arg1 Int64
+	if tmp, ok := rawArgs["maxReadBytes"]; ok {
+		var err error
+		err = (&arg1).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxReadBytes"] = arg1
+	var arg2 Int64
+	if tmp, ok := rawArgs["seek"]; ok {
+		var err error
+		err = (&arg2).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["seek"] = arg2
+	return args, nil
+
+}
+
+func field_RegularFile_hash_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 HashAlgorithm
+	if tmp, ok := rawArgs["algorithm"]; ok {
+		var err error
+		err = (&arg0).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["algorithm"] = arg0
+	return args, nil
+
+}
+
+func field_RegularFile_lines_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["from"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["count"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalInt(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["count"] = arg1
+	var arg2 bool
+	if tmp, ok := rawArgs["fromEnd"]; ok {
+		var err error
+		arg2, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["fromEnd"] = arg2
+	return args, nil
+
+}
+
+func field_Subscription_watch_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["path"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["recursive"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["recursive"] = arg1
+	return args, nil
+
+}
+
+func field_Subscription_follow_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["path"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["path"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["fromEnd"]; ok {
+		var err error
+		arg1, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["fromEnd"] = arg1
+	var arg2 Encoding
+	if tmp, ok := rawArgs["encoding"]; ok {
+		var err error
+		err = (&arg2).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["encoding"] = arg2
+	return args, nil
+
+}
+
+func field___Type_fields_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+
+}
+
+func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalBoolean(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+
+}
+
+func dir_scalarInfo_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["baseType"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["baseType"] = arg0
+	return args, nil
+
+}
+
+type executableSchema struct {
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	return parsedSchema
+}
+
+func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
+	switch typeName + "." + field {
+
+	case "CopyResult.s":
+		if e.complexity.CopyResult.S == nil {
+			break
+		}
+
+		return e.complexity.CopyResult.S(childComplexity), true
+
+	case "CopyResult.warning":
+		if e.complexity.CopyResult.Warning == nil {
+			break
+		}
+
+		return e.complexity.CopyResult.Warning(childComplexity), true
+
+	case "CopyResult.file":
+		if e.complexity.CopyResult.File == nil {
+			break
+		}
+
+		return e.complexity.CopyResult.File(childComplexity), true
+
+	case "CopyResult.files":
+		if e.complexity.CopyResult.Files == nil {
+			break
+		}
+
+		return e.complexity.CopyResult.Files(childComplexity), true
+
+	case "CopyResult.bytes":
+		if e.complexity.CopyResult.Bytes == nil {
+			break
+		}
+
+		return e.complexity.CopyResult.Bytes(childComplexity), true
+
+	case "Device.id":
+		if e.complexity.Device.Id == nil {
+			break
+		}
+
+		return e.complexity.Device.Id(childComplexity), true
+
+	case "Device.name":
+		if e.complexity.Device.Name == nil {
+			break
+		}
+
+		return e.complexity.Device.Name(childComplexity), true
+
+	case "Device.path":
+		if e.complexity.Device.Path == nil {
+			break
+		}
+
+		return e.complexity.Device.Path(childComplexity), true
+
+	case "Device.size":
+		if e.complexity.Device.Size == nil {
+			break
+		}
+
+		return e.complexity.Device.Size(childComplexity), true
+
+	case "Device.mode":
+		if e.complexity.Device.Mode == nil {
+			break
+		}
+
+		return e.complexity.Device.Mode(childComplexity), true
+
+	case "Device.modTime":
+		if e.complexity.Device.ModTime == nil {
+			break
+		}
+
+		return e.complexity.Device.ModTime(childComplexity), true
+
+	case "Device.version":
+		if e.complexity.Device.Version == nil {
+			break
+		}
+
+		return e.complexity.Device.Version(childComplexity), true
+
+	case "Device.parent":
+		if e.complexity.Device.Parent == nil {
+			break
+		}
+
+		return e.complexity.Device.Parent(childComplexity), true
+
+	case "Device.major":
+		if e.complexity.Device.Major == nil {
+			break
+		}
+
+		return e.complexity.Device.Major(childComplexity), true
+
+	case "Device.minor":
+		if e.complexity.Device.Minor == nil {
+			break
+		}
+
+		return e.complexity.Device.Minor(childComplexity), true
+
+	case "Dir.id":
+		if e.complexity.Dir.Id == nil {
+			break
+		}
+
+		return e.complexity.Dir.Id(childComplexity), true
+
+	case "Dir.name":
+		if e.complexity.Dir.Name == nil {
+			break
+		}
+
+		return e.complexity.Dir.Name(childComplexity), true
+
+	case "Dir.path":
+		if e.complexity.Dir.Path == nil {
+			break
+		}
+
+		return e.complexity.Dir.Path(childComplexity), true
+
+	case "Dir.size":
+		if e.complexity.Dir.Size == nil {
+			break
+		}
+
+		return e.complexity.Dir.Size(childComplexity), true
+
+	case "Dir.mode":
+		if e.complexity.Dir.Mode == nil {
+			break
+		}
+
+		return e.complexity.Dir.Mode(childComplexity), true
+
+	case "Dir.modTime":
+		if e.complexity.Dir.ModTime == nil {
+			break
+		}
+
+		return e.complexity.Dir.ModTime(childComplexity), true
+
+	case "Dir.version":
+		if e.complexity.Dir.Version == nil {
+			break
+		}
+
+		return e.complexity.Dir.Version(childComplexity), true
+
+	case "Dir.parent":
+		if e.complexity.Dir.Parent == nil {
+			break
+		}
+
+		return e.complexity.Dir.Parent(childComplexity), true
+
+	case "Dir.children":
+		if e.complexity.Dir.Children == nil {
+			break
+		}
+
+		args, err := field_Dir_children_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Dir.Children(childComplexity, args["first"].(int), args["orderBy"].(*FileOrder), args["filter"].(*FileFilter)), true
+
+	case "Dir.childrenConnection":
+		if e.complexity.Dir.ChildrenConnection == nil {
+			break
+		}
+
+		args, err := field_Dir_childrenConnection_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Dir.ChildrenConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "Dir.file":
+		if e.complexity.Dir.File == nil {
+			break
+		}
+
+		args, err := field_Dir_file_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Dir.File(childComplexity, args["path"].(string), args["followSymlinks"].(bool)), true
+
+	case "Dir.walk":
+		if e.complexity.Dir.Walk == nil {
+			break
+		}
+
+		args, err := field_Dir_walk_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Dir.Walk(childComplexity, args["maxDepth"].(int), args["filter"].(*FileFilter), args["first"].(*int), args["after"].(*string)), true
+
+	case "FileConnection.edges":
+		if e.complexity.FileConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.FileConnection.Edges(childComplexity), true
+
+	case "FileConnection.pageInfo":
+		if e.complexity.FileConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.FileConnection.PageInfo(childComplexity), true
+
+	case "FileConnection.totalCount":
+		if e.complexity.FileConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.FileConnection.TotalCount(childComplexity), true
+
+	case "FileContents.data":
+		if e.complexity.FileContents.Data == nil {
+			break
+		}
+
+		return e.complexity.FileContents.Data(childComplexity), true
+
+	case "FileContents.next":
+		if e.complexity.FileContents.Next == nil {
+			break
+		}
+
+		return e.complexity.FileContents.Next(childComplexity), true
+
+	case "FileContents.encoding":
+		if e.complexity.FileContents.Encoding == nil {
+			break
+		}
+
+		return e.complexity.FileContents.Encoding(childComplexity), true
+
+	case "FileContents.warning":
+		if e.complexity.FileContents.Warning == nil {
+			break
+		}
+
+		return e.complexity.FileContents.Warning(childComplexity), true
+
+	case "FileEdge.cursor":
+		if e.complexity.FileEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.FileEdge.Cursor(childComplexity), true
+
+	case "FileEdge.node":
+		if e.complexity.FileEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.FileEdge.Node(childComplexity), true
+
+	case "FileEvent.type":
+		if e.complexity.FileEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.FileEvent.Type(childComplexity), true
+
+	case "FileEvent.path":
+		if e.complexity.FileEvent.Path == nil {
+			break
+		}
+
+		return e.complexity.FileEvent.Path(childComplexity), true
+
+	case "FileEvent.oldPath":
+		if e.complexity.FileEvent.OldPath == nil {
+			break
+		}
+
+		return e.complexity.FileEvent.OldPath(childComplexity), true
+
+	case "FileEvent.file":
+		if e.complexity.FileEvent.File == nil {
+			break
+		}
+
+		return e.complexity.FileEvent.File(childComplexity), true
+
+	case "FileLines.lines":
+		if e.complexity.FileLines.Lines == nil {
+			break
+		}
+
+		return e.complexity.FileLines.Lines(childComplexity), true
+
+	case "FileLines.totalLines":
+		if e.complexity.FileLines.TotalLines == nil {
+			break
+		}
+
+		return e.complexity.FileLines.TotalLines(childComplexity), true
+
+	case "FileLines.warning":
+		if e.complexity.FileLines.Warning == nil {
+			break
+		}
+
+		return e.complexity.FileLines.Warning(childComplexity), true
+
+	case "FileMode.type":
+		if e.complexity.FileMode.Type == nil {
+			break
+		}
+
+		return e.complexity.FileMode.Type(childComplexity), true
+
+	case "FileMode.perm":
+		if e.complexity.FileMode.Perm == nil {
+			break
+		}
+
+		return e.complexity.FileMode.Perm(childComplexity), true
+
+	case "FileMode.sticky":
+		if e.complexity.FileMode.Sticky == nil {
+			break
+		}
+
+		return e.complexity.FileMode.Sticky(childComplexity), true
+
+	case "FileResult.s":
+		if e.complexity.FileResult.S == nil {
+			break
+		}
+
+		return e.complexity.FileResult.S(childComplexity), true
+
+	case "FileResult.warning":
+		if e.complexity.FileResult.Warning == nil {
+			break
+		}
+
+		return e.complexity.FileResult.Warning(childComplexity), true
+
+	case "FileResult.file":
+		if e.complexity.FileResult.File == nil {
+			break
+		}
+
+		return e.complexity.FileResult.File(childComplexity), true
+
+	case "FollowEvent.type":
+		if e.complexity.FollowEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.FollowEvent.Type(childComplexity), true
+
+	case "FollowEvent.data":
+		if e.complexity.FollowEvent.Data == nil {
+			break
+		}
+
+		return e.complexity.FollowEvent.Data(childComplexity), true
+
+	case "FollowEvent.encoding":
+		if e.complexity.FollowEvent.Encoding == nil {
+			break
+		}
+
+		return e.complexity.FollowEvent.Encoding(childComplexity), true
+
+	case "FollowEvent.offset":
+		if e.complexity.FollowEvent.Offset == nil {
+			break
+		}
+
+		return e.complexity.FollowEvent.Offset(childComplexity), true
+
+	case "FollowEvent.next":
+		if e.complexity.FollowEvent.Next == nil {
+			break
+		}
+
+		return e.complexity.FollowEvent.Next(childComplexity), true
+
+	case "FollowEvent.warning":
+		if e.complexity.FollowEvent.Warning == nil {
+			break
+		}
+
+		return e.complexity.FollowEvent.Warning(childComplexity), true
+
+	case "GrepFile.file":
+		if e.complexity.GrepFile.File == nil {
+			break
+		}
+
+		return e.complexity.GrepFile.File(childComplexity), true
+
+	case "GrepFile.matches":
+		if e.complexity.GrepFile.Matches == nil {
+			break
+		}
+
+		return e.complexity.GrepFile.Matches(childComplexity), true
+
+	case "GrepMatch.line":
+		if e.complexity.GrepMatch.Line == nil {
+			break
+		}
+
+		return e.complexity.GrepMatch.Line(childComplexity), true
+
+	case "GrepMatch.offset":
+		if e.complexity.GrepMatch.Offset == nil {
+			break
+		}
+
+		return e.complexity.GrepMatch.Offset(childComplexity), true
+
+	case "GrepMatch.column":
+		if e.complexity.GrepMatch.Column == nil {
+			break
+		}
+
+		return e.complexity.GrepMatch.Column(childComplexity), true
+
+	case "GrepMatch.length":
+		if e.complexity.GrepMatch.Length == nil {
+			break
+		}
+
+		return e.complexity.GrepMatch.Length(childComplexity), true
+
+	case "GrepMatch.text":
+		if e.complexity.GrepMatch.Text == nil {
+			break
+		}
+
+		return e.complexity.GrepMatch.Text(childComplexity), true
+
+	case "GrepMatch.before":
+		if e.complexity.GrepMatch.Before == nil {
+			break
+		}
+
+		return e.complexity.GrepMatch.Before(childComplexity), true
+
+	case "GrepMatch.after":
+		if e.complexity.GrepMatch.After == nil {
+			break
+		}
+
+		return e.complexity.GrepMatch.After(childComplexity), true
+
+	case "GrepResult.files":
+		if e.complexity.GrepResult.Files == nil {
+			break
+		}
+
+		return e.complexity.GrepResult.Files(childComplexity), true
+
+	case "GrepResult.matchCount":
+		if e.complexity.GrepResult.MatchCount == nil {
+			break
+		}
+
+		return e.complexity.GrepResult.MatchCount(childComplexity), true
+
+	case "GrepResult.truncated":
+		if e.complexity.GrepResult.Truncated == nil {
+			break
+		}
+
+		return e.complexity.GrepResult.Truncated(childComplexity), true
+
+	case "IrregularFile.id":
+		if e.complexity.IrregularFile.Id == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.Id(childComplexity), true
+
+	case "IrregularFile.name":
+		if e.complexity.IrregularFile.Name == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.Name(childComplexity), true
+
+	case "IrregularFile.path":
+		if e.complexity.IrregularFile.Path == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.Path(childComplexity), true
+
+	case "IrregularFile.size":
+		if e.complexity.IrregularFile.Size == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.Size(childComplexity), true
+
+	case "IrregularFile.mode":
+		if e.complexity.IrregularFile.Mode == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.Mode(childComplexity), true
+
+	case "IrregularFile.modTime":
+		if e.complexity.IrregularFile.ModTime == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.ModTime(childComplexity), true
+
+	case "IrregularFile.version":
+		if e.complexity.IrregularFile.Version == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.Version(childComplexity), true
+
+	case "IrregularFile.parent":
+		if e.complexity.IrregularFile.Parent == nil {
+			break
+		}
+
+		return e.complexity.IrregularFile.Parent(childComplexity), true
+
+	case "Line.number":
+		if e.complexity.Line.Number == nil {
+			break
+		}
+
+		return e.complexity.Line.Number(childComplexity), true
+
+	case "Line.text":
+		if e.complexity.Line.Text == nil {
+			break
+		}
+
+		return e.complexity.Line.Text(childComplexity), true
+
+	case "Mutation.remove":
+		if e.complexity.Mutation.Remove == nil {
+			break
+		}
+
+		args, err := field_Mutation_remove_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Remove(childComplexity, args["path"].(string), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string)), true
+
+	case "Mutation.removeAll":
+		if e.complexity.Mutation.RemoveAll == nil {
+			break
+		}
+
+		args, err := field_Mutation_removeAll_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveAll(childComplexity, args["path"].(string), args["dryRun"].(bool)), true
+
+	case "Mutation.rename":
+		if e.complexity.Mutation.Rename == nil {
+			break
+		}
+
+		args, err := field_Mutation_rename_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Rename(childComplexity, args["path"].(string), args["newName"].(string), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string), args["ifNotExists"].(bool)), true
+
+	case "Mutation.chmod":
+		if e.complexity.Mutation.Chmod == nil {
+			break
+		}
+
+		args, err := field_Mutation_chmod_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Chmod(childComplexity, args["path"].(string), args["mode"].(int), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string)), true
+
+	case "Mutation.write":
+		if e.complexity.Mutation.Write == nil {
+			break
+		}
+
+		args, err := field_Mutation_write_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Write(childComplexity, args["path"].(string), args["contents"].(string), args["open"].([]FileOpen), args["encoding"].(Encoding), args["atomic"].(bool), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string), args["ifNotExists"].(bool)), true
+
+	case "Mutation.copy":
+		if e.complexity.Mutation.Copy == nil {
+			break
+		}
+
+		args, err := field_Mutation_copy_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Copy(childComplexity, args["path"].(string), args["destPath"].(string), args["recursive"].(bool), args["overwrite"].(bool), args["preserveMode"].(bool), args["preserveTimes"].(bool)), true
+
+	case "Mutation.move":
+		if e.complexity.Mutation.Move == nil {
+			break
+		}
+
+		args, err := field_Mutation_move_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Move(childComplexity, args["path"].(string), args["destPath"].(string), args["overwrite"].(bool)), true
+
+	case "Mutation.symlink":
+		if e.complexity.Mutation.Symlink == nil {
+			break
+		}
+
+		args, err := field_Mutation_symlink_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Symlink(childComplexity, args["target"].(string), args["path"].(string)), true
+
+	case "Mutation.link":
+		if e.complexity.Mutation.Link == nil {
+			break
+		}
+
+		args, err := field_Mutation_link_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Link(childComplexity, args["existingPath"].(string), args["newPath"].(string)), true
+
+	case "Mutation.chtimes":
+		if e.complexity.Mutation.Chtimes == nil {
+			break
+		}
+
+		args, err := field_Mutation_chtimes_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Chtimes(childComplexity, args["path"].(string), args["modTime"].(string), args["accessTime"].(*string)), true
+
+	case "Mutation.touch":
+		if e.complexity.Mutation.Touch == nil {
+			break
+		}
+
+		args, err := field_Mutation_touch_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Touch(childComplexity, args["path"].(string), args["createIfMissing"].(bool)), true
+
+	case "Mutation.writeAt":
+		if e.complexity.Mutation.WriteAt == nil {
+			break
+		}
+
+		args, err := field_Mutation_writeAt_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.WriteAt(childComplexity, args["path"].(string), args["offset"].(Int64), args["contents"].(string), args["encoding"].(Encoding)), true
+
+	case "Mutation.truncate":
+		if e.complexity.Mutation.Truncate == nil {
+			break
+		}
+
+		args, err := field_Mutation_truncate_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Truncate(childComplexity, args["path"].(string), args["size"].(Int64)), true
+
+	case "Mutation.upload":
+		if e.complexity.Mutation.Upload == nil {
+			break
+		}
+
+		args, err := field_Mutation_upload_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Upload(childComplexity, args["path"].(string), args["file"].(Upload), args["open"].([]FileOpen)), true
+
+	case "Mutation.mkdir":
+		if e.complexity.Mutation.Mkdir == nil {
+			break
+		}
+
+		args, err := field_Mutation_mkdir_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Mkdir(childComplexity, args["path"].(string)), true
+
+	case "Mutation.mkdirAll":
+		if e.complexity.Mutation.MkdirAll == nil {
+			break
+		}
+
+		args, err := field_Mutation_mkdirAll_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MkdirAll(childComplexity, args["path"].(string)), true
+
+	case "NamedPipe.id":
+		if e.complexity.NamedPipe.Id == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.Id(childComplexity), true
+
+	case "NamedPipe.name":
+		if e.complexity.NamedPipe.Name == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.Name(childComplexity), true
+
+	case "NamedPipe.path":
+		if e.complexity.NamedPipe.Path == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.Path(childComplexity), true
+
+	case "NamedPipe.size":
+		if e.complexity.NamedPipe.Size == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.Size(childComplexity), true
+
+	case "NamedPipe.mode":
+		if e.complexity.NamedPipe.Mode == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.Mode(childComplexity), true
+
+	case "NamedPipe.modTime":
+		if e.complexity.NamedPipe.ModTime == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.ModTime(childComplexity), true
+
+	case "NamedPipe.version":
+		if e.complexity.NamedPipe.Version == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.Version(childComplexity), true
+
+	case "NamedPipe.parent":
+		if e.complexity.NamedPipe.Parent == nil {
+			break
+		}
+
+		return e.complexity.NamedPipe.Parent(childComplexity), true
+
+	case "OKResult.s":
+		if e.complexity.Okresult.S == nil {
+			break
+		}
+
+		return e.complexity.Okresult.S(childComplexity), true
+
+	case "OKResult.warning":
+		if e.complexity.Okresult.Warning == nil {
+			break
+		}
+
+		return e.complexity.Okresult.Warning(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "Query.root":
+		if e.complexity.Query.Root == nil {
+			break
+		}
+
+		return e.complexity.Query.Root(childComplexity), true
+
+	case "Query.cd":
+		if e.complexity.Query.Cd == nil {
+			break
+		}
+
+		args, err := field_Query_cd_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Cd(childComplexity, args["path"].(string)), true
+
+	case "Query.file":
+		if e.complexity.Query.File == nil {
+			break
+		}
+
+		args, err := field_Query_file_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.File(childComplexity, args["path"].(string), args["followSymlinks"].(bool)), true
+
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
+			break
+		}
+
+		args, err := field_Query_node_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Node(childComplexity, args["id"].(string)), true
+
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
+			break
+		}
+
+		args, err := field_Query_nodes_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]string)), true
+
+	case "Query.find":
+		if e.complexity.Query.Find == nil {
+			break
+		}
+
+		args, err := field_Query_find_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Find(childComplexity, args["path"].(string), args["maxDepth"].(int), args["filter"].(*FileFilter), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.grep":
+		if e.complexity.Query.Grep == nil {
+			break
+		}
+
+		args, err := field_Query_grep_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Grep(childComplexity, args["pattern"].(string), args["regex"].(bool), args["caseInsensitive"].(bool), args["path"].(string), args["include"].([]string), args["maxMatches"].(int), args["contextLines"].(int)), true
+
+	case "RegularFile.id":
+		if e.complexity.RegularFile.Id == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.Id(childComplexity), true
+
+	case "RegularFile.name":
+		if e.complexity.RegularFile.Name == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.Name(childComplexity), true
+
+	case "RegularFile.path":
+		if e.complexity.RegularFile.Path == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.Path(childComplexity), true
+
+	case "RegularFile.size":
+		if e.complexity.RegularFile.Size == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.Size(childComplexity), true
+
+	case "RegularFile.mode":
+		if e.complexity.RegularFile.Mode == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.Mode(childComplexity), true
+
+	case "RegularFile.modTime":
+		if e.complexity.RegularFile.ModTime == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.ModTime(childComplexity), true
+
+	case "RegularFile.version":
+		if e.complexity.RegularFile.Version == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.Version(childComplexity), true
+
+	case "RegularFile.parent":
+		if e.complexity.RegularFile.Parent == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.Parent(childComplexity), true
+
+	case "RegularFile.contents":
+		if e.complexity.RegularFile.Contents == nil {
+			break
+		}
+
+		args, err := field_RegularFile_contents_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.RegularFile.Contents(childComplexity, args["encoding"].(Encoding), args["maxReadBytes"].(Int64), args["seek"].(Int64)), true
+
+	case "RegularFile.hash":
+		if e.complexity.RegularFile.Hash == nil {
+			break
+		}
+
+		args, err := field_RegularFile_hash_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.RegularFile.Hash(childComplexity, args["algorithm"].(HashAlgorithm)), true
+
+	case "RegularFile.mimeType":
+		if e.complexity.RegularFile.MimeType == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.MimeType(childComplexity), true
+
+	case "RegularFile.isText":
+		if e.complexity.RegularFile.IsText == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.IsText(childComplexity), true
+
+	case "RegularFile.lines":
+		if e.complexity.RegularFile.Lines == nil {
+			break
+		}
+
+		args, err := field_RegularFile_lines_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.RegularFile.Lines(childComplexity, args["from"].(int), args["count"].(int), args["fromEnd"].(bool)), true
+
+	case "RegularFile.downloadURL":
+		if e.complexity.RegularFile.DownloadUrl == nil {
+			break
+		}
+
+		return e.complexity.RegularFile.DownloadUrl(childComplexity), true
+
+	case "RemoveAllResult.s":
+		if e.complexity.RemoveAllResult.S == nil {
+			break
+		}
+
+		return e.complexity.RemoveAllResult.S(childComplexity), true
+
+	case "RemoveAllResult.warning":
+		if e.complexity.RemoveAllResult.Warning == nil {
+			break
+		}
+
+		return e.complexity.RemoveAllResult.Warning(childComplexity), true
+
+	case "RemoveAllResult.paths":
+		if e.complexity.RemoveAllResult.Paths == nil {
+			break
+		}
+
+		return e.complexity.RemoveAllResult.Paths(childComplexity), true
+
+	case "RemoveAllResult.bytes":
+		if e.complexity.RemoveAllResult.Bytes == nil {
+			break
+		}
+
+		return e.complexity.RemoveAllResult.Bytes(childComplexity), true
+
+	case "Socket.id":
+		if e.complexity.Socket.Id == nil {
+			break
+		}
+
+		return e.complexity.Socket.Id(childComplexity), true
+
+	case "Socket.name":
+		if e.complexity.Socket.Name == nil {
+			break
+		}
+
+		return e.complexity.Socket.Name(childComplexity), true
+
+	case "Socket.path":
+		if e.complexity.Socket.Path == nil {
+			break
+		}
+
+		return e.complexity.Socket.Path(childComplexity), true
+
+	case "Socket.size":
+		if e.complexity.Socket.Size == nil {
+			break
+		}
+
+		return e.complexity.Socket.Size(childComplexity), true
+
+	case "Socket.mode":
+		if e.complexity.Socket.Mode == nil {
+			break
+		}
+
+		return e.complexity.Socket.Mode(childComplexity), true
+
+	case "Socket.modTime":
+		if e.complexity.Socket.ModTime == nil {
+			break
+		}
+
+		return e.complexity.Socket.ModTime(childComplexity), true
+
+	case "Socket.version":
+		if e.complexity.Socket.Version == nil {
+			break
+		}
+
+		return e.complexity.Socket.Version(childComplexity), true
+
+	case "Socket.parent":
+		if e.complexity.Socket.Parent == nil {
+			break
+		}
+
+		return e.complexity.Socket.Parent(childComplexity), true
+
+	case "Subscription.watch":
+		if e.complexity.Subscription.Watch == nil {
+			break
+		}
+
+		args, err := field_Subscription_watch_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.Watch(childComplexity, args["path"].(string), args["recursive"].(bool)), true
+
+	case "Subscription.follow":
+		if e.complexity.Subscription.Follow == nil {
+			break
+		}
+
+		args, err := field_Subscription_follow_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.Follow(childComplexity, args["path"].(string), args["fromEnd"].(bool), args["encoding"].(Encoding)), true
+
+	case "Symlink.id":
+		if e.complexity.Symlink.Id == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Id(childComplexity), true
+
+	case "Symlink.name":
+		if e.complexity.Symlink.Name == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Name(childComplexity), true
+
+	case "Symlink.path":
+		if e.complexity.Symlink.Path == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Path(childComplexity), true
+
+	case "Symlink.size":
+		if e.complexity.Symlink.Size == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Size(childComplexity), true
+
+	case "Symlink.mode":
+		if e.complexity.Symlink.Mode == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Mode(childComplexity), true
+
+	case "Symlink.modTime":
+		if e.complexity.Symlink.ModTime == nil {
+			break
+		}
+
+		return e.complexity.Symlink.ModTime(childComplexity), true
+
+	case "Symlink.version":
+		if e.complexity.Symlink.Version == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Version(childComplexity), true
+
+	case "Symlink.parent":
+		if e.complexity.Symlink.Parent == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Parent(childComplexity), true
+
+	case "Symlink.target":
+		if e.complexity.Symlink.Target == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Target(childComplexity), true
+
+	case "Symlink.resolved":
+		if e.complexity.Symlink.Resolved == nil {
+			break
+		}
+
+		return e.complexity.Symlink.Resolved(childComplexity), true
+
+	case "WalkConnection.edges":
+		if e.complexity.WalkConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.WalkConnection.Edges(childComplexity), true
+
+	case "WalkConnection.pageInfo":
+		if e.complexity.WalkConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.WalkConnection.PageInfo(childComplexity), true
+
+	case "WalkEdge.cursor":
+		if e.complexity.WalkEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.WalkEdge.Cursor(childComplexity), true
+
+	case "WalkEdge.node":
+		if e.complexity.WalkEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.WalkEdge.Node(childComplexity), true
+
+	case "WalkEdge.depth":
+		if e.complexity.WalkEdge.Depth == nil {
+			break
+		}
+
+		return e.complexity.WalkEdge.Depth(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+		data := ec._Query(ctx, op.SelectionSet)
+		var buf bytes.Buffer
+		data.MarshalGQL(&buf)
+		return buf.Bytes()
+	})
+
+	return &graphql.Response{
+		Data:       buf,
+		Errors:     ec.Errors,
+		Extensions: ec.Extensions}
+}
+
+func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+		data := ec._Mutation(ctx, op.SelectionSet)
+		var buf bytes.Buffer
+		data.MarshalGQL(&buf)
+		return buf.Bytes()
+	})
+
+	return &graphql.Response{
+		Data:       buf,
+		Errors:     ec.Errors,
+		Extensions: ec.Extensions,
+	}
+}
+
+func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	next := ec._Subscription(ctx, op.SelectionSet)
+	if ec.Errors != nil {
+		return graphql.OneShot(&graphql.Response{Data: []byte("null"), Errors: ec.Errors})
+	}
+
+	var buf bytes.Buffer
+	return func() *graphql.Response {
+		buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+			buf.Reset()
+			data := next()
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+			return buf.Bytes()
+		})
+
+		if buf == nil {
+			return nil
+		}
+
+		return &graphql.Response{
+			Data:       buf,
+			Errors:     ec.Errors,
+			Extensions: ec.Extensions,
+		}
+	}
+}
+
+type executionContext struct {
+	*graphql.RequestContext
+	*executableSchema
+}
+
+var copyResultImplementors = []string{"CopyResult", "Result"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _CopyResult(ctx context.Context, sel ast.SelectionSet, obj *CopyResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, copyResultImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CopyResult")
+		case "s":
+			out.Values[i] = ec._CopyResult_s(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "warning":
+			out.Values[i] = ec._CopyResult_warning(ctx, field, obj)
+		case "file":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._CopyResult_file(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "files":
+			out.Values[i] = ec._CopyResult_files(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "bytes":
+			out.Values[i] = ec._CopyResult_bytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CopyResult_s(ctx context.Context, field graphql.CollectedField, obj *CopyResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "CopyResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.S, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CopyResult_warning(ctx context.Context, field graphql.CollectedField, obj *CopyResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "CopyResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Warning, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CopyResult_file(ctx context.Context, field graphql.CollectedField, obj *CopyResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "CopyResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CopyResult().File(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CopyResult_files(ctx context.Context, field graphql.CollectedField, obj *CopyResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "CopyResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Files, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _CopyResult_bytes(ctx context.Context, field graphql.CollectedField, obj *CopyResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "CopyResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bytes, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+var deviceImplementors = []string{"Device", "File", "Node"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Device(ctx context.Context, sel ast.SelectionSet, obj *Device) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, deviceImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Device")
+		case "id":
+			out.Values[i] = ec._Device_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._Device_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "path":
+			out.Values[i] = ec._Device_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "size":
+			out.Values[i] = ec._Device_size(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._Device_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modTime":
+			out.Values[i] = ec._Device_modTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "version":
+			out.Values[i] = ec._Device_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "parent":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Device_parent(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "major":
+			out.Values[i] = ec._Device_major(ctx, field, obj)
+		case "minor":
+			out.Values[i] = ec._Device_minor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_id(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_name(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_path(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_size(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_mode(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileMode)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileMode(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_modTime(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModTime(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_version(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_parent(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Device().Parent(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_major(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Major(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Device_minor(ctx context.Context, field graphql.CollectedField, obj *Device) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Device",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Minor(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*res)
+}
+
+var dirImplementors = []string{"Dir", "File", "Node"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Dir(ctx context.Context, sel ast.SelectionSet, obj *Dir) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, dirImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Dir")
+		case "id":
+			out.Values[i] = ec._Dir_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._Dir_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "path":
+			out.Values[i] = ec._Dir_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "size":
+			out.Values[i] = ec._Dir_size(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._Dir_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modTime":
+			out.Values[i] = ec._Dir_modTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "version":
+			out.Values[i] = ec._Dir_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "parent":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Dir_parent(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "children":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Dir_children(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "childrenConnection":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Dir_childrenConnection(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "file":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Dir_file(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "walk":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Dir_walk(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_id(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_name(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_path(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_size(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_mode(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileMode)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileMode(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_modTime(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModTime(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_version(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_parent(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Dir().Parent(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_children(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Dir_children_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Dir().Children(rctx, obj, args["first"].(int), args["orderBy"].(*FileOrder), args["filter"].(*FileFilter))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._File(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_childrenConnection(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Dir_childrenConnection_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Dir().ChildrenConnection(rctx, obj, args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileConnection)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileConnection(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_file(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Dir_file_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Dir().File(rctx, obj, args["path"].(string), args["followSymlinks"].(bool))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Dir_walk(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Dir_walk_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Dir",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Dir().Walk(rctx, obj, args["maxDepth"].(int), args["filter"].(*FileFilter), args["first"].(*int), args["after"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(WalkConnection)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._WalkConnection(ctx, field.Selections, &res)
+}
+
+var fileConnectionImplementors = []string{"FileConnection"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FileConnection(ctx context.Context, sel ast.SelectionSet, obj *FileConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, fileConnectionImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileConnection")
+		case "edges":
+			out.Values[i] = ec._FileConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "pageInfo":
+			out.Values[i] = ec._FileConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "totalCount":
+			out.Values[i] = ec._FileConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileConnection_edges(ctx context.Context, field graphql.CollectedField, obj *FileConnection) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileConnection",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]FileEdge)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._FileEdge(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *FileConnection) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileConnection",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(PageInfo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._PageInfo(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *FileConnection) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileConnection",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+var fileContentsImplementors = []string{"FileContents"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FileContents(ctx context.Context, sel ast.SelectionSet, obj *FileContents) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, fileContentsImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileContents")
+		case "data":
+			out.Values[i] = ec._FileContents_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "next":
+			out.Values[i] = ec._FileContents_next(ctx, field, obj)
+		case "encoding":
+			out.Values[i] = ec._FileContents_encoding(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "warning":
+			out.Values[i] = ec._FileContents_warning(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileContents_data(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileContents",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileContents_next(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileContents",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Next, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return *res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileContents_encoding(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileContents",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Encoding, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Encoding)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileContents_warning(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileContents",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Warning, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+var fileEdgeImplementors = []string{"FileEdge"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FileEdge(ctx context.Context, sel ast.SelectionSet, obj *FileEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, fileEdgeImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileEdge")
+		case "cursor":
+			out.Values[i] = ec._FileEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "node":
+			out.Values[i] = ec._FileEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *FileEdge) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileEdge",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileEdge_node(ctx context.Context, field graphql.CollectedField, obj *FileEdge) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileEdge",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+var fileEventImplementors = []string{"FileEvent"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FileEvent(ctx context.Context, sel ast.SelectionSet, obj *FileEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, fileEventImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileEvent")
+		case "type":
+			out.Values[i] = ec._FileEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "path":
+			out.Values[i] = ec._FileEvent_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "oldPath":
+			out.Values[i] = ec._FileEvent_oldPath(ctx, field, obj)
+		case "file":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._FileEvent_file(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileEvent_type(ctx context.Context, field graphql.CollectedField, obj *FileEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileEventType)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileEvent_path(ctx context.Context, field graphql.CollectedField, obj *FileEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileEvent_oldPath(ctx context.Context, field graphql.CollectedField, obj *FileEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OldPath, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileEvent_file(ctx context.Context, field graphql.CollectedField, obj *FileEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FileEvent().File(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+var fileLinesImplementors = []string{"FileLines"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FileLines(ctx context.Context, sel ast.SelectionSet, obj *FileLines) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, fileLinesImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileLines")
+		case "lines":
+			out.Values[i] = ec._FileLines_lines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "totalLines":
+			out.Values[i] = ec._FileLines_totalLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "warning":
+			out.Values[i] = ec._FileLines_warning(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileLines_lines(ctx context.Context, field graphql.CollectedField, obj *FileLines) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileLines",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lines, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]Line)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._Line(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileLines_totalLines(ctx context.Context, field graphql.CollectedField, obj *FileLines) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileLines",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalLines, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileLines_warning(ctx context.Context, field graphql.CollectedField, obj *FileLines) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileLines",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Warning, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+var fileModeImplementors = []string{"FileMode"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FileMode(ctx context.Context, sel ast.SelectionSet, obj *FileMode) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, fileModeImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileMode")
+		case "type":
+			out.Values[i] = ec._FileMode_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "perm":
+			out.Values[i] = ec._FileMode_perm(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "sticky":
+			out.Values[i] = ec._FileMode_sticky(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileMode_type(ctx context.Context, field graphql.CollectedField, obj *FileMode) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileMode",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileType)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileMode_perm(ctx context.Context, field graphql.CollectedField, obj *FileMode) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileMode",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Perm, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileMode_sticky(ctx context.Context, field graphql.CollectedField, obj *FileMode) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileMode",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sticky, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalBoolean(res)
+}
+
+var fileResultImplementors = []string{"FileResult", "Result"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FileResult(ctx context.Context, sel ast.SelectionSet, obj *FileResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, fileResultImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FileResult")
+		case "s":
+			out.Values[i] = ec._FileResult_s(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "warning":
+			out.Values[i] = ec._FileResult_warning(ctx, field, obj)
+		case "file":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._FileResult_file(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileResult_s(ctx context.Context, field graphql.CollectedField, obj *FileResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.S, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileResult_warning(ctx context.Context, field graphql.CollectedField, obj *FileResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Warning, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FileResult_file(ctx context.Context, field graphql.CollectedField, obj *FileResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FileResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FileResult().File(rctx, obj)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+var followEventImplementors = []string{"FollowEvent"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _FollowEvent(ctx context.Context, sel ast.SelectionSet, obj *FollowEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, followEventImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FollowEvent")
+		case "type":
+			out.Values[i] = ec._FollowEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "data":
+			out.Values[i] = ec._FollowEvent_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "encoding":
+			out.Values[i] = ec._FollowEvent_encoding(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "offset":
+			out.Values[i] = ec._FollowEvent_offset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "next":
+			out.Values[i] = ec._FollowEvent_next(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "warning":
+			out.Values[i] = ec._FollowEvent_warning(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FollowEvent_type(ctx context.Context, field graphql.CollectedField, obj *FollowEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FollowEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FollowEventType)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FollowEvent_data(ctx context.Context, field graphql.CollectedField, obj *FollowEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FollowEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FollowEvent_encoding(ctx context.Context, field graphql.CollectedField, obj *FollowEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FollowEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Encoding, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Encoding)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FollowEvent_offset(ctx context.Context, field graphql.CollectedField, obj *FollowEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FollowEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Offset, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FollowEvent_next(ctx context.Context, field graphql.CollectedField, obj *FollowEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FollowEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Next, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _FollowEvent_warning(ctx context.Context, field graphql.CollectedField, obj *FollowEvent) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "FollowEvent",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Warning, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+var grepFileImplementors = []string{"GrepFile"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _GrepFile(ctx context.Context, sel ast.SelectionSet, obj *GrepFile) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, grepFileImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GrepFile")
+		case "file":
+			out.Values[i] = ec._GrepFile_file(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "matches":
+			out.Values[i] = ec._GrepFile_matches(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepFile_file(ctx context.Context, field graphql.CollectedField, obj *GrepFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.File, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepFile_matches(ctx context.Context, field graphql.CollectedField, obj *GrepFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Matches, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]GrepMatch)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._GrepMatch(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+var grepMatchImplementors = []string{"GrepMatch"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _GrepMatch(ctx context.Context, sel ast.SelectionSet, obj *GrepMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, grepMatchImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GrepMatch")
+		case "line":
+			out.Values[i] = ec._GrepMatch_line(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "offset":
+			out.Values[i] = ec._GrepMatch_offset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "column":
+			out.Values[i] = ec._GrepMatch_column(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "length":
+			out.Values[i] = ec._GrepMatch_length(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "text":
+			out.Values[i] = ec._GrepMatch_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "before":
+			out.Values[i] = ec._GrepMatch_before(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "after":
+			out.Values[i] = ec._GrepMatch_after(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepMatch_line(ctx context.Context, field graphql.CollectedField, obj *GrepMatch) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepMatch",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Line, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepMatch_offset(ctx context.Context, field graphql.CollectedField, obj *GrepMatch) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepMatch",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Offset, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepMatch_column(ctx context.Context, field graphql.CollectedField, obj *GrepMatch) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepMatch",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Column, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepMatch_length(ctx context.Context, field graphql.CollectedField, obj *GrepMatch) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepMatch",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Length, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepMatch_text(ctx context.Context, field graphql.CollectedField, obj *GrepMatch) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepMatch",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Text, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepMatch_before(ctx context.Context, field graphql.CollectedField, obj *GrepMatch) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepMatch",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Before, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepMatch_after(ctx context.Context, field graphql.CollectedField, obj *GrepMatch) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepMatch",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.After, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+var grepResultImplementors = []string{"GrepResult"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _GrepResult(ctx context.Context, sel ast.SelectionSet, obj *GrepResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, grepResultImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GrepResult")
+		case "files":
+			out.Values[i] = ec._GrepResult_files(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "matchCount":
+			out.Values[i] = ec._GrepResult_matchCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "truncated":
+			out.Values[i] = ec._GrepResult_truncated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepResult_files(ctx context.Context, field graphql.CollectedField, obj *GrepResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Files, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]GrepFile)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._GrepFile(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
+	}
+	wg.Wait()
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepResult_matchCount(ctx context.Context, field graphql.CollectedField, obj *GrepResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MatchCount, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _GrepResult_truncated(ctx context.Context, field graphql.CollectedField, obj *GrepResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "GrepResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Truncated, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalBoolean(res)
+}
+
+var irregularFileImplementors = []string{"IrregularFile", "File", "Node"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _IrregularFile(ctx context.Context, sel ast.SelectionSet, obj *IrregularFile) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, irregularFileImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IrregularFile")
+		case "id":
+			out.Values[i] = ec._IrregularFile_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._IrregularFile_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "path":
+			out.Values[i] = ec._IrregularFile_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "size":
+			out.Values[i] = ec._IrregularFile_size(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._IrregularFile_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modTime":
+			out.Values[i] = ec._IrregularFile_modTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "version":
+			out.Values[i] = ec._IrregularFile_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "parent":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._IrregularFile_parent(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_id(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_name(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_path(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_size(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_mode(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileMode)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileMode(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_modTime(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModTime(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_version(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _IrregularFile_parent(ctx context.Context, field graphql.CollectedField, obj *IrregularFile) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "IrregularFile",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.IrregularFile().Parent(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+var lineImplementors = []string{"Line"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Line(ctx context.Context, sel ast.SelectionSet, obj *Line) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, lineImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Line")
+		case "number":
+			out.Values[i] = ec._Line_number(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "text":
+			out.Values[i] = ec._Line_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Line_number(ctx context.Context, field graphql.CollectedField, obj *Line) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Line",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Number, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalInt(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Line_text(ctx context.Context, field graphql.CollectedField, obj *Line) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Line",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Text, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, mutationImplementors)
+
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "remove":
+			out.Values[i] = ec._Mutation_remove(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "removeAll":
+			out.Values[i] = ec._Mutation_removeAll(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "rename":
+			out.Values[i] = ec._Mutation_rename(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "chmod":
+			out.Values[i] = ec._Mutation_chmod(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "write":
+			out.Values[i] = ec._Mutation_write(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "copy":
+			out.Values[i] = ec._Mutation_copy(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "move":
+			out.Values[i] = ec._Mutation_move(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "symlink":
+			out.Values[i] = ec._Mutation_symlink(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "link":
+			out.Values[i] = ec._Mutation_link(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "chtimes":
+			out.Values[i] = ec._Mutation_chtimes(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "touch":
+			out.Values[i] = ec._Mutation_touch(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "writeAt":
+			out.Values[i] = ec._Mutation_writeAt(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "truncate":
+			out.Values[i] = ec._Mutation_truncate(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "upload":
+			out.Values[i] = ec._Mutation_upload(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "mkdir":
+			out.Values[i] = ec._Mutation_mkdir(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "mkdirAll":
+			out.Values[i] = ec._Mutation_mkdirAll(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_remove(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_remove_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Remove(rctx, args["path"].(string), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(OKResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._OKResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_removeAll(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_removeAll_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveAll(rctx, args["path"].(string), args["dryRun"].(bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(RemoveAllResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._RemoveAllResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_rename(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_rename_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Rename(rctx, args["path"].(string), args["newName"].(string), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string), args["ifNotExists"].(bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_chmod(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_chmod_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Chmod(rctx, args["path"].(string), args["mode"].(int), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_write(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_write_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Write(rctx, args["path"].(string), args["contents"].(string), args["open"].([]FileOpen), args["encoding"].(Encoding), args["atomic"].(bool), args["ifMatch"].(*string), args["ifUnmodifiedSince"].(*string), args["ifNotExists"].(bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_copy(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_copy_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Copy(rctx, args["path"].(string), args["destPath"].(string), args["recursive"].(bool), args["overwrite"].(bool), args["preserveMode"].(bool), args["preserveTimes"].(bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(CopyResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._CopyResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_move(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_move_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Move(rctx, args["path"].(string), args["destPath"].(string), args["overwrite"].(bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_symlink(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_symlink_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Symlink(rctx, args["target"].(string), args["path"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_link(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_link_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Link(rctx, args["existingPath"].(string), args["newPath"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_chtimes(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_chtimes_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Chtimes(rctx, args["path"].(string), args["modTime"].(string), args["accessTime"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_touch(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_touch_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Touch(rctx, args["path"].(string), args["createIfMissing"].(bool))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_writeAt(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_writeAt_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().WriteAt(rctx, args["path"].(string), args["offset"].(Int64), args["contents"].(string), args["encoding"].(Encoding))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_truncate(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_truncate_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Truncate(rctx, args["path"].(string), args["size"].(Int64))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_upload(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_upload_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Upload(rctx, args["path"].(string), args["file"].(Upload), args["open"].([]FileOpen))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_mkdir(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_mkdir_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Mkdir(rctx, args["path"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Mutation_mkdirAll(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Mutation_mkdirAll_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().MkdirAll(rctx, args["path"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileResult(ctx, field.Selections, &res)
+}
+
+var namedPipeImplementors = []string{"NamedPipe", "File", "Node"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _NamedPipe(ctx context.Context, sel ast.SelectionSet, obj *NamedPipe) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, namedPipeImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NamedPipe")
+		case "id":
+			out.Values[i] = ec._NamedPipe_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._NamedPipe_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "path":
+			out.Values[i] = ec._NamedPipe_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "size":
+			out.Values[i] = ec._NamedPipe_size(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._NamedPipe_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modTime":
+			out.Values[i] = ec._NamedPipe_modTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "version":
+			out.Values[i] = ec._NamedPipe_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "parent":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._NamedPipe_parent(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_id(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalID(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_name(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_path(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_size(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size(), nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_mode(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(FileMode)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._FileMode(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_modTime(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModTime(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_version(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version(), nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _NamedPipe_parent(ctx context.Context, field graphql.CollectedField, obj *NamedPipe) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "NamedPipe",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.NamedPipe().Parent(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+var oKResultImplementors = []string{"OKResult", "Result"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _OKResult(ctx context.Context, sel ast.SelectionSet, obj *OKResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, oKResultImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OKResult")
+		case "s":
+			out.Values[i] = ec._OKResult_s(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "warning":
+			out.Values[i] = ec._OKResult_warning(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OKResult_s(ctx context.Context, field graphql.CollectedField, obj *OKResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "OKResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.S, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _OKResult_warning(ctx context.Context, field graphql.CollectedField, obj *OKResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "OKResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Warning, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, pageInfoImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "PageInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "PageInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasPreviousPage, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "PageInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartCursor, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "PageInfo",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndCursor, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+var queryImplementors = []string{"Query"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, queryImplementors)
+
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Query",
+	})
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "root":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_root(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "cd":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_cd(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "file":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_file(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "node":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_node(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "nodes":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_nodes(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "find":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_find(ctx, field)
+				wg.Done()
+			}(i, field)
+		case "grep":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Query_grep(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_root(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Root(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Dir)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._Dir(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_cd(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_cd_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Cd(rctx, args["path"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Dir)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Dir(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_file(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_file_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().File(rctx, args["path"].(string), args["followSymlinks"].(bool))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(File)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._File(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_node(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_node_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Node(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(Node)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._Node(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_nodes(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_nodes_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Nodes(rctx, args["ids"].([]string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
+	}
+	res := resTmp.([]Node)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-		return e.complexity.RegularFile.Name(childComplexity), true
-
-	case "RegularFile.path":
-		if e.complexity.RegularFile.Path == nil {
-			break
-		}
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
 
-		return e.complexity.RegularFile.Path(childComplexity), true
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
+	}
 
-	case "RegularFile.size":
-		if e.complexity.RegularFile.Size == nil {
-			break
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
 		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
 
-		return e.complexity.RegularFile.Size(childComplexity), true
-
-	case "RegularFile.mode":
-		if e.complexity.RegularFile.Mode == nil {
-			break
+				return ec._Node(ctx, field.Selections, &res[idx1])
+			}()
 		}
-
-		return e.complexity.RegularFile.Mode(childComplexity), true
-
-	case "RegularFile.modTime":
-		if e.complexity.RegularFile.ModTime == nil {
-			break
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
 		}
 
-		return e.complexity.RegularFile.ModTime(childComplexity), true
+	}
+	wg.Wait()
+	return arr1
+}
 
-	case "RegularFile.parent":
-		if e.complexity.RegularFile.Parent == nil {
-			break
-		}
+// nolint: vetshadow
+func (ec *executionContext) _Query_find(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_find_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Find(rctx, args["path"].(string), args["maxDepth"].(int), args["filter"].(*FileFilter), args["first"].(*int), args["after"].(*string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*WalkConnection)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-		return e.complexity.RegularFile.Parent(childComplexity), true
+	if res == nil {
+		return graphql.Null
+	}
 
-	case "RegularFile.contents":
-		if e.complexity.RegularFile.Contents == nil {
-			break
-		}
+	return ec._WalkConnection(ctx, field.Selections, res)
+}
 
-		args, err := field_RegularFile_contents_args(rawArgs)
-		if err != nil {
-			return 0, false
+// nolint: vetshadow
+func (ec *executionContext) _Query_grep(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query_grep_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Grep(rctx, args["pattern"].(string), args["regex"].(bool), args["caseInsensitive"].(bool), args["path"].(string), args["include"].([]string), args["maxMatches"].(int), args["contextLines"].(int))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
 		}
-
-		return e.complexity.RegularFile.Contents(childComplexity, args["encoding"].(Encoding), args["maxReadBytes"].(Int64), args["seek"].(Int64)), true
-
+		return graphql.Null
 	}
-	return 0, false
-}
+	res := resTmp.(GrepResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	return ec._GrepResult(ctx, field.Selections, &res)
+}
 
-	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
-		data := ec._Query(ctx, op.SelectionSet)
-		var buf bytes.Buffer
-		data.MarshalGQL(&buf)
-		return buf.Bytes()
+// nolint: vetshadow
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Query___type_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string)), nil
 	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	return &graphql.Response{
-		Data:       buf,
-		Errors:     ec.Errors,
-		Extensions: ec.Extensions}
-}
+	if res == nil {
+		return graphql.Null
+	}
 
-func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	return ec.___Type(ctx, field.Selections, res)
+}
 
-	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
-		data := ec._Mutation(ctx, op.SelectionSet)
-		var buf bytes.Buffer
-		data.MarshalGQL(&buf)
-		return buf.Bytes()
+// nolint: vetshadow
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema(), nil
 	})
-
-	return &graphql.Response{
-		Data:       buf,
-		Errors:     ec.Errors,
-		Extensions: ec.Extensions,
+	if resTmp == nil {
+		return graphql.Null
 	}
-}
+	res := resTmp.(*introspection.Schema)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
-	return graphql.OneShot(graphql.ErrorResponse(ctx, "subscriptions are not supported"))
-}
+	if res == nil {
+		return graphql.Null
+	}
 
-type executionContext struct {
-	*graphql.RequestContext
-	*executableSchema
+	return ec.___Schema(ctx, field.Selections, res)
 }
 
-var dirImplementors = []string{"Dir", "File"}
+var regularFileImplementors = []string{"RegularFile", "File", "Node"}
 
 // nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Dir(ctx context.Context, sel ast.SelectionSet, obj *Dir) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, dirImplementors)
+func (ec *executionContext) _RegularFile(ctx context.Context, sel ast.SelectionSet, obj *RegularFile) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, regularFileImplementors)
 
 	var wg sync.WaitGroup
 	out := graphql.NewOrderedMap(len(fields))
@@ -888,55 +7594,86 @@ func (ec *executionContext) _Dir(ctx context.Context, sel ast.SelectionSet, obj
 
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Dir")
+			out.Values[i] = graphql.MarshalString("RegularFile")
 		case "id":
-			out.Values[i] = ec._Dir_id(ctx, field, obj)
+			out.Values[i] = ec._RegularFile_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "name":
-			out.Values[i] = ec._Dir_name(ctx, field, obj)
+			out.Values[i] = ec._RegularFile_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "path":
-			out.Values[i] = ec._Dir_path(ctx, field, obj)
+			out.Values[i] = ec._RegularFile_path(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "size":
-			out.Values[i] = ec._Dir_size(ctx, field, obj)
+			out.Values[i] = ec._RegularFile_size(ctx, field, obj)
 		case "mode":
-			out.Values[i] = ec._Dir_mode(ctx, field, obj)
+			out.Values[i] = ec._RegularFile_mode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "modTime":
-			out.Values[i] = ec._Dir_modTime(ctx, field, obj)
+			out.Values[i] = ec._RegularFile_modTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "version":
+			out.Values[i] = ec._RegularFile_version(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "parent":
 			wg.Add(1)
 			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Dir_parent(ctx, field, obj)
+				out.Values[i] = ec._RegularFile_parent(ctx, field, obj)
 				wg.Done()
 			}(i, field)
-		case "children":
+		case "contents":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._RegularFile_contents(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "hash":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._RegularFile_hash(ctx, field, obj)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			}(i, field)
+		case "mimeType":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._RegularFile_mimeType(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "isText":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._RegularFile_isText(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "lines":
 			wg.Add(1)
 			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Dir_children(ctx, field, obj)
+				out.Values[i] = ec._RegularFile_lines(ctx, field, obj)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
 			}(i, field)
-		case "file":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Dir_file(ctx, field, obj)
-				wg.Done()
-			}(i, field)
+		case "downloadURL":
+			out.Values[i] = ec._RegularFile_downloadURL(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -949,11 +7686,11 @@ func (ec *executionContext) _Dir(ctx context.Context, sel ast.SelectionSet, obj
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_id(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_id(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -976,11 +7713,11 @@ func (ec *executionContext) _Dir_id(ctx context.Context, field graphql.Collected
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_name(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_name(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1003,11 +7740,11 @@ func (ec *executionContext) _Dir_name(ctx context.Context, field graphql.Collect
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_path(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_path(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1030,11 +7767,11 @@ func (ec *executionContext) _Dir_path(ctx context.Context, field graphql.Collect
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_size(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_size(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1054,11 +7791,11 @@ func (ec *executionContext) _Dir_size(ctx context.Context, field graphql.Collect
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_mode(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_mode(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1082,11 +7819,11 @@ func (ec *executionContext) _Dir_mode(ctx context.Context, field graphql.Collect
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_modTime(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_modTime(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1109,11 +7846,11 @@ func (ec *executionContext) _Dir_modTime(ctx context.Context, field graphql.Coll
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_parent(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_version(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1121,96 +7858,57 @@ func (ec *executionContext) _Dir_parent(ctx context.Context, field graphql.Colle
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Dir().Parent(rctx, obj)
+		return obj.Version(), nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(File)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._File(ctx, field.Selections, &res)
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_children(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_parent(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Dir_children_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
-		Args:   args,
+		Object: "RegularFile",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Dir().Children(rctx, obj, args["first"].(int))
+		return ec.resolvers.RegularFile().Parent(rctx, obj)
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]File)
+	res := resTmp.(File)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	arr1 := make(graphql.Array, len(res))
-	var wg sync.WaitGroup
-
-	isLen1 := len(res) == 1
-	if !isLen1 {
-		wg.Add(len(res))
-	}
-
-	for idx1 := range res {
-		idx1 := idx1
-		rctx := &graphql.ResolverContext{
-			Index:  &idx1,
-			Result: &res[idx1],
-		}
-		ctx := graphql.WithResolverContext(ctx, rctx)
-		f := func(idx1 int) {
-			if !isLen1 {
-				defer wg.Done()
-			}
-			arr1[idx1] = func() graphql.Marshaler {
-
-				return ec._File(ctx, field.Selections, &res[idx1])
-			}()
-		}
-		if isLen1 {
-			f(idx1)
-		} else {
-			go f(idx1)
-		}
-
-	}
-	wg.Wait()
-	return arr1
+	return ec._File(ctx, field.Selections, &res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Dir_file(ctx context.Context, field graphql.CollectedField, obj *Dir) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_contents(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Dir_file_args(rawArgs)
+	args, err := field_RegularFile_contents_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	rctx := &graphql.ResolverContext{
-		Object: "Dir",
+		Object: "RegularFile",
 		Args:   args,
 		Field:  field,
 	}
@@ -1218,71 +7916,7 @@ func (ec *executionContext) _Dir_file(ctx context.Context, field graphql.Collect
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Dir().File(rctx, obj, args["path"].(string))
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(File)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._File(ctx, field.Selections, &res)
-}
-
-var fileContentsImplementors = []string{"FileContents"}
-
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _FileContents(ctx context.Context, sel ast.SelectionSet, obj *FileContents) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, fileContentsImplementors)
-
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FileContents")
-		case "data":
-			out.Values[i] = ec._FileContents_data(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "next":
-			out.Values[i] = ec._FileContents_next(ctx, field, obj)
-		case "encoding":
-			out.Values[i] = ec._FileContents_encoding(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "warning":
-			out.Values[i] = ec._FileContents_warning(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
-
-// nolint: vetshadow
-func (ec *executionContext) _FileContents_data(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "FileContents",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return ec.resolvers.RegularFile().Contents(rctx, obj, args["encoding"].(Encoding), args["maxReadBytes"].(Int64), args["seek"].(Int64))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1290,54 +7924,33 @@ func (ec *executionContext) _FileContents_data(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(FileContents)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalString(res)
+
+	return ec._FileContents(ctx, field.Selections, &res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileContents_next(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_hash(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "FileContents",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Next, nil
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*Int64)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	if res == nil {
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_RegularFile_hash_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	return *res
-}
-
-// nolint: vetshadow
-func (ec *executionContext) _FileContents_encoding(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "FileContents",
-		Args:   nil,
+		Object: "RegularFile",
+		Args:   args,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Encoding, nil
+		return ec.resolvers.RegularFile().Hash(rctx, obj, args["algorithm"].(HashAlgorithm))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1345,18 +7958,18 @@ func (ec *executionContext) _FileContents_encoding(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(Encoding)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return res
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileContents_warning(ctx context.Context, field graphql.CollectedField, obj *FileContents) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_mimeType(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "FileContents",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1364,67 +7977,27 @@ func (ec *executionContext) _FileContents_warning(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Warning, nil
+		return ec.resolvers.RegularFile().MimeType(rctx, obj)
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
 	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	if res == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalString(*res)
-}
-
-var fileModeImplementors = []string{"FileMode"}
-
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _FileMode(ctx context.Context, sel ast.SelectionSet, obj *FileMode) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, fileModeImplementors)
-
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FileMode")
-		case "type":
-			out.Values[i] = ec._FileMode_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "perm":
-			out.Values[i] = ec._FileMode_perm(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "sticky":
-			out.Values[i] = ec._FileMode_sticky(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	if invalid {
+	if res == nil {
 		return graphql.Null
 	}
-	return out
+	return graphql.MarshalString(*res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileMode_type(ctx context.Context, field graphql.CollectedField, obj *FileMode) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_isText(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "FileMode",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1432,34 +8005,41 @@ func (ec *executionContext) _FileMode_type(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.RegularFile().IsText(rctx, obj)
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(FileType)
+	res := resTmp.(*bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return res
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalBoolean(*res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileMode_perm(ctx context.Context, field graphql.CollectedField, obj *FileMode) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_lines(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_RegularFile_lines_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
 	rctx := &graphql.ResolverContext{
-		Object: "FileMode",
-		Args:   nil,
+		Object: "RegularFile",
+		Args:   args,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Perm, nil
+		return ec.resolvers.RegularFile().Lines(rctx, obj, args["from"].(int), args["count"].(int), args["fromEnd"].(bool))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1467,18 +8047,19 @@ func (ec *executionContext) _FileMode_perm(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(FileLines)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalInt(res)
+
+	return ec._FileLines(ctx, field.Selections, &res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileMode_sticky(ctx context.Context, field graphql.CollectedField, obj *FileMode) graphql.Marshaler {
+func (ec *executionContext) _RegularFile_downloadURL(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "FileMode",
+		Object: "RegularFile",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1486,27 +8067,27 @@ func (ec *executionContext) _FileMode_sticky(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sticky, nil
+		return obj.DownloadURL(), nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalBoolean(res)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
 }
 
-var fileResultImplementors = []string{"FileResult", "Result"}
+var removeAllResultImplementors = []string{"RemoveAllResult", "Result"}
 
 // nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _FileResult(ctx context.Context, sel ast.SelectionSet, obj *FileResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, fileResultImplementors)
+func (ec *executionContext) _RemoveAllResult(ctx context.Context, sel ast.SelectionSet, obj *RemoveAllResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, removeAllResultImplementors)
 
-	var wg sync.WaitGroup
 	out := graphql.NewOrderedMap(len(fields))
 	invalid := false
 	for i, field := range fields {
@@ -1514,28 +8095,29 @@ func (ec *executionContext) _FileResult(ctx context.Context, sel ast.SelectionSe
 
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FileResult")
+			out.Values[i] = graphql.MarshalString("RemoveAllResult")
 		case "s":
-			out.Values[i] = ec._FileResult_s(ctx, field, obj)
+			out.Values[i] = ec._RemoveAllResult_s(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "warning":
-			out.Values[i] = ec._FileResult_warning(ctx, field, obj)
-		case "file":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._FileResult_file(ctx, field, obj)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
+			out.Values[i] = ec._RemoveAllResult_warning(ctx, field, obj)
+		case "paths":
+			out.Values[i] = ec._RemoveAllResult_paths(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "bytes":
+			out.Values[i] = ec._RemoveAllResult_bytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	wg.Wait()
+
 	if invalid {
 		return graphql.Null
 	}
@@ -1543,11 +8125,11 @@ func (ec *executionContext) _FileResult(ctx context.Context, sel ast.SelectionSe
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileResult_s(ctx context.Context, field graphql.CollectedField, obj *FileResult) graphql.Marshaler {
+func (ec *executionContext) _RemoveAllResult_s(ctx context.Context, field graphql.CollectedField, obj *RemoveAllResult) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "FileResult",
+		Object: "RemoveAllResult",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1570,11 +8152,11 @@ func (ec *executionContext) _FileResult_s(ctx context.Context, field graphql.Col
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileResult_warning(ctx context.Context, field graphql.CollectedField, obj *FileResult) graphql.Marshaler {
+func (ec *executionContext) _RemoveAllResult_warning(ctx context.Context, field graphql.CollectedField, obj *RemoveAllResult) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "FileResult",
+		Object: "RemoveAllResult",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1598,11 +8180,11 @@ func (ec *executionContext) _FileResult_warning(ctx context.Context, field graph
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _FileResult_file(ctx context.Context, field graphql.CollectedField, obj *FileResult) graphql.Marshaler {
+func (ec *executionContext) _RemoveAllResult_paths(ctx context.Context, field graphql.CollectedField, obj *RemoveAllResult) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "FileResult",
+		Object: "RemoveAllResult",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1610,7 +8192,7 @@ func (ec *executionContext) _FileResult_file(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.FileResult().File(rctx, obj)
+		return obj.Paths, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1618,18 +8200,53 @@ func (ec *executionContext) _FileResult_file(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(File)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	return ec._File(ctx, field.Selections, &res)
+	arr1 := make(graphql.Array, len(res))
+
+	for idx1 := range res {
+		arr1[idx1] = func() graphql.Marshaler {
+			return graphql.MarshalString(res[idx1])
+		}()
+	}
+
+	return arr1
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _RemoveAllResult_bytes(ctx context.Context, field graphql.CollectedField, obj *RemoveAllResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "RemoveAllResult",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bytes, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Int64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
 }
 
-var internal_OtherFileImplementors = []string{"Internal_OtherFile", "File"}
+var socketImplementors = []string{"Socket", "File", "Node"}
 
 // nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Internal_OtherFile(ctx context.Context, sel ast.SelectionSet, obj *Internal_OtherFile) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, internal_OtherFileImplementors)
+func (ec *executionContext) _Socket(ctx context.Context, sel ast.SelectionSet, obj *Socket) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, socketImplementors)
 
 	var wg sync.WaitGroup
 	out := graphql.NewOrderedMap(len(fields))
@@ -1639,41 +8256,43 @@ func (ec *executionContext) _Internal_OtherFile(ctx context.Context, sel ast.Sel
 
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Internal_OtherFile")
+			out.Values[i] = graphql.MarshalString("Socket")
 		case "id":
-			out.Values[i] = ec._Internal_OtherFile_id(ctx, field, obj)
+			out.Values[i] = ec._Socket_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "name":
-			out.Values[i] = ec._Internal_OtherFile_name(ctx, field, obj)
+			out.Values[i] = ec._Socket_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "path":
-			out.Values[i] = ec._Internal_OtherFile_path(ctx, field, obj)
+			out.Values[i] = ec._Socket_path(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "size":
-			out.Values[i] = ec._Internal_OtherFile_size(ctx, field, obj)
+			out.Values[i] = ec._Socket_size(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._Socket_mode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "mode":
-			out.Values[i] = ec._Internal_OtherFile_mode(ctx, field, obj)
+		case "modTime":
+			out.Values[i] = ec._Socket_modTime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "modTime":
-			out.Values[i] = ec._Internal_OtherFile_modTime(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._Socket_version(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "parent":
 			wg.Add(1)
 			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Internal_OtherFile_parent(ctx, field, obj)
+				out.Values[i] = ec._Socket_parent(ctx, field, obj)
 				wg.Done()
 			}(i, field)
 		default:
@@ -1688,11 +8307,11 @@ func (ec *executionContext) _Internal_OtherFile(ctx context.Context, sel ast.Sel
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Internal_OtherFile_id(ctx context.Context, field graphql.CollectedField, obj *Internal_OtherFile) graphql.Marshaler {
+func (ec *executionContext) _Socket_id(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Internal_OtherFile",
+		Object: "Socket",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1715,11 +8334,11 @@ func (ec *executionContext) _Internal_OtherFile_id(ctx context.Context, field gr
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Internal_OtherFile_name(ctx context.Context, field graphql.CollectedField, obj *Internal_OtherFile) graphql.Marshaler {
+func (ec *executionContext) _Socket_name(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Internal_OtherFile",
+		Object: "Socket",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1742,11 +8361,11 @@ func (ec *executionContext) _Internal_OtherFile_name(ctx context.Context, field
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Internal_OtherFile_path(ctx context.Context, field graphql.CollectedField, obj *Internal_OtherFile) graphql.Marshaler {
+func (ec *executionContext) _Socket_path(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Internal_OtherFile",
+		Object: "Socket",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1769,11 +8388,11 @@ func (ec *executionContext) _Internal_OtherFile_path(ctx context.Context, field
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Internal_OtherFile_size(ctx context.Context, field graphql.CollectedField, obj *Internal_OtherFile) graphql.Marshaler {
+func (ec *executionContext) _Socket_size(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Internal_OtherFile",
+		Object: "Socket",
 		Args:   nil,
 		Field:  field,
 	}
@@ -1784,176 +8403,28 @@ func (ec *executionContext) _Internal_OtherFile_size(ctx context.Context, field
 		return obj.Size(), nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(Int64)
 	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return res
-}
-
-// nolint: vetshadow
-func (ec *executionContext) _Internal_OtherFile_mode(ctx context.Context, field graphql.CollectedField, obj *Internal_OtherFile) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "Internal_OtherFile",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Mode(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(FileMode)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._FileMode(ctx, field.Selections, &res)
-}
-
-// nolint: vetshadow
-func (ec *executionContext) _Internal_OtherFile_modTime(ctx context.Context, field graphql.CollectedField, obj *Internal_OtherFile) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "Internal_OtherFile",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ModTime(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalString(res)
-}
-
-// nolint: vetshadow
-func (ec *executionContext) _Internal_OtherFile_parent(ctx context.Context, field graphql.CollectedField, obj *Internal_OtherFile) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "Internal_OtherFile",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Internal_OtherFile().Parent(rctx, obj)
-	})
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(File)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._File(ctx, field.Selections, &res)
-}
-
-var mutationImplementors = []string{"Mutation"}
-
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, mutationImplementors)
-
-	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
-		Object: "Mutation",
-	})
-
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "remove":
-			out.Values[i] = ec._Mutation_remove(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "rename":
-			out.Values[i] = ec._Mutation_rename(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "chmod":
-			out.Values[i] = ec._Mutation_chmod(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "write":
-			out.Values[i] = ec._Mutation_write(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "mkdir":
-			out.Values[i] = ec._Mutation_mkdir(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "mkdirAll":
-			out.Values[i] = ec._Mutation_mkdirAll(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-
-	if invalid {
-		return graphql.Null
-	}
-	return out
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return res
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Mutation_remove(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Socket_mode(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_remove_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
+		Object: "Socket",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Remove(rctx, args["path"].(string))
+		return obj.Mode(), nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1961,33 +8432,27 @@ func (ec *executionContext) _Mutation_remove(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(OKResult)
+	res := resTmp.(FileMode)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	return ec._OKResult(ctx, field.Selections, &res)
+	return ec._FileMode(ctx, field.Selections, &res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Mutation_rename(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Socket_modTime(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_rename_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
+		Object: "Socket",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Rename(rctx, args["path"].(string), args["newName"].(string))
+		return obj.ModTime(), nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1995,33 +8460,26 @@ func (ec *executionContext) _Mutation_rename(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FileResult)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._FileResult(ctx, field.Selections, &res)
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Mutation_chmod(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Socket_version(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_chmod_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
+		Object: "Socket",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Chmod(rctx, args["path"].(string), args["mode"].(int))
+		return obj.Version(), nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2029,67 +8487,213 @@ func (ec *executionContext) _Mutation_chmod(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FileResult)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._FileResult(ctx, field.Selections, &res)
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Mutation_write(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Socket_parent(ctx context.Context, field graphql.CollectedField, obj *Socket) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_write_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
+		Object: "Socket",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Write(rctx, args["path"].(string), args["contents"].(string), args["open"].([]FileOpen), args["encoding"].(Encoding))
+		return ec.resolvers.Socket().Parent(rctx, obj)
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(FileResult)
+	res := resTmp.(File)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	return ec._FileResult(ctx, field.Selections, &res)
+	return ec._File(ctx, field.Selections, &res)
 }
 
-// nolint: vetshadow
-func (ec *executionContext) _Mutation_mkdir(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, subscriptionImplementors)
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "watch":
+		return ec._Subscription_watch(ctx, fields[0])
+	case "follow":
+		return ec._Subscription_follow(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+func (ec *executionContext) _Subscription_watch(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Field: field,
+	})
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_mkdir_args(rawArgs)
+	args, err := field_Subscription_watch_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	// FIXME: subscriptions are missing request middleware stack https://github.com/99designs/gqlgen/issues/259
+	//          and Tracer stack
+	rctx := ctx
+	results, err := ec.resolvers.Subscription().Watch(rctx, args["path"].(string), args["recursive"].(bool))
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-results
+		if !ok {
+			return nil
+		}
+		var out graphql.OrderedMap
+		out.Add(field.Alias, func() graphql.Marshaler {
+
+			return ec._FileEvent(ctx, field.Selections, &res)
+		}())
+		return &out
+	}
+}
+
+func (ec *executionContext) _Subscription_follow(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Field: field,
+	})
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := field_Subscription_follow_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	// FIXME: subscriptions are missing request middleware stack https://github.com/99designs/gqlgen/issues/259
+	//          and Tracer stack
+	rctx := ctx
+	results, err := ec.resolvers.Subscription().Follow(rctx, args["path"].(string), args["fromEnd"].(bool), args["encoding"].(Encoding))
 	if err != nil {
 		ec.Error(ctx, err)
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-results
+		if !ok {
+			return nil
+		}
+		var out graphql.OrderedMap
+		out.Add(field.Alias, func() graphql.Marshaler {
+
+			return ec._FollowEvent(ctx, field.Selections, &res)
+		}())
+		return &out
+	}
+}
+
+var symlinkImplementors = []string{"Symlink", "File", "Node"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Symlink(ctx context.Context, sel ast.SelectionSet, obj *Symlink) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, symlinkImplementors)
+
+	var wg sync.WaitGroup
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Symlink")
+		case "id":
+			out.Values[i] = ec._Symlink_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._Symlink_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "path":
+			out.Values[i] = ec._Symlink_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "size":
+			out.Values[i] = ec._Symlink_size(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._Symlink_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "modTime":
+			out.Values[i] = ec._Symlink_modTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "version":
+			out.Values[i] = ec._Symlink_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "parent":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Symlink_parent(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "target":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Symlink_target(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		case "resolved":
+			wg.Add(1)
+			go func(i int, field graphql.CollectedField) {
+				out.Values[i] = ec._Symlink_resolved(ctx, field, obj)
+				wg.Done()
+			}(i, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	wg.Wait()
+	if invalid {
 		return graphql.Null
 	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Symlink_id(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
+		Object: "Symlink",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Mkdir(rctx, args["path"].(string))
+		return obj.ID(), nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2097,33 +8701,26 @@ func (ec *executionContext) _Mutation_mkdir(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FileResult)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._FileResult(ctx, field.Selections, &res)
+	return graphql.MarshalID(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Mutation_mkdirAll(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Symlink_name(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Mutation_mkdirAll_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
-		Args:   args,
+		Object: "Symlink",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().MkdirAll(rctx, args["path"].(string))
+		return obj.Name(), nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2131,51 +8728,45 @@ func (ec *executionContext) _Mutation_mkdirAll(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FileResult)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._FileResult(ctx, field.Selections, &res)
+	return graphql.MarshalString(res)
 }
 
-var oKResultImplementors = []string{"OKResult", "Result"}
-
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _OKResult(ctx context.Context, sel ast.SelectionSet, obj *OKResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, oKResultImplementors)
-
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("OKResult")
-		case "s":
-			out.Values[i] = ec._OKResult_s(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "warning":
-			out.Values[i] = ec._OKResult_warning(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+// nolint: vetshadow
+func (ec *executionContext) _Symlink_path(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer ec.Tracer.EndFieldExecution(ctx)
+	rctx := &graphql.ResolverContext{
+		Object: "Symlink",
+		Args:   nil,
+		Field:  field,
 	}
-
-	if invalid {
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _OKResult_s(ctx context.Context, field graphql.CollectedField, obj *OKResult) graphql.Marshaler {
+func (ec *executionContext) _Symlink_size(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "OKResult",
+		Object: "Symlink",
 		Args:   nil,
 		Field:  field,
 	}
@@ -2183,26 +8774,23 @@ func (ec *executionContext) _OKResult_s(ctx context.Context, field graphql.Colle
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.S, nil
+		return obj.Size(), nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(Int64)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalString(res)
+	return res
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _OKResult_warning(ctx context.Context, field graphql.CollectedField, obj *OKResult) graphql.Marshaler {
+func (ec *executionContext) _Symlink_mode(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "OKResult",
+		Object: "Symlink",
 		Args:   nil,
 		Field:  field,
 	}
@@ -2210,90 +8798,35 @@ func (ec *executionContext) _OKResult_warning(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Warning, nil
+		return obj.Mode(), nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(FileMode)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	if res == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalString(*res)
-}
-
-var queryImplementors = []string{"Query"}
-
-// nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, queryImplementors)
-
-	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
-		Object: "Query",
-	})
-
-	var wg sync.WaitGroup
-	out := graphql.NewOrderedMap(len(fields))
-	invalid := false
-	for i, field := range fields {
-		out.Keys[i] = field.Alias
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "root":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_root(ctx, field)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
-		case "cd":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_cd(ctx, field)
-				wg.Done()
-			}(i, field)
-		case "file":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_file(ctx, field)
-				wg.Done()
-			}(i, field)
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	wg.Wait()
-	if invalid {
-		return graphql.Null
-	}
-	return out
+	return ec._FileMode(ctx, field.Selections, &res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query_root(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Symlink_modTime(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Symlink",
 		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Root(rctx)
+		return obj.ModTime(), nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2301,68 +8834,53 @@ func (ec *executionContext) _Query_root(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(Dir)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._Dir(ctx, field.Selections, &res)
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query_cd(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Symlink_version(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_cd_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
+		Object: "Symlink",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Cd(rctx, args["path"].(string))
+		return obj.Version(), nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*Dir)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	if res == nil {
-		return graphql.Null
-	}
-
-	return ec._Dir(ctx, field.Selections, res)
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query_file(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Symlink_parent(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_file_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
+		Object: "Symlink",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().File(rctx, args["path"].(string))
+		return ec.resolvers.Symlink().Parent(rctx, obj)
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -2375,76 +8893,64 @@ func (ec *executionContext) _Query_file(ctx context.Context, field graphql.Colle
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Symlink_target(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query___type_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
-		Args:   args,
+		Object: "Symlink",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string)), nil
+		return ec.resolvers.Symlink().Target(rctx, obj)
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
 	if res == nil {
 		return graphql.Null
 	}
-
-	return ec.___Type(ctx, field.Selections, res)
+	return graphql.MarshalString(*res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Symlink_resolved(ctx context.Context, field graphql.CollectedField, obj *Symlink) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Symlink",
 		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema(), nil
+		return ec.resolvers.Symlink().Resolved(rctx, obj)
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(File)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	if res == nil {
-		return graphql.Null
-	}
-
-	return ec.___Schema(ctx, field.Selections, res)
+	return ec._File(ctx, field.Selections, &res)
 }
 
-var regularFileImplementors = []string{"RegularFile", "File"}
+var walkConnectionImplementors = []string{"WalkConnection"}
 
 // nolint: gocyclo, errcheck, gas, goconst
-func (ec *executionContext) _RegularFile(ctx context.Context, sel ast.SelectionSet, obj *RegularFile) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, regularFileImplementors)
+func (ec *executionContext) _WalkConnection(ctx context.Context, sel ast.SelectionSet, obj *WalkConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, walkConnectionImplementors)
 
-	var wg sync.WaitGroup
 	out := graphql.NewOrderedMap(len(fields))
 	invalid := false
 	for i, field := range fields {
@@ -2452,120 +8958,34 @@ func (ec *executionContext) _RegularFile(ctx context.Context, sel ast.SelectionS
 
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RegularFile")
-		case "id":
-			out.Values[i] = ec._RegularFile_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "name":
-			out.Values[i] = ec._RegularFile_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "path":
-			out.Values[i] = ec._RegularFile_path(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "size":
-			out.Values[i] = ec._RegularFile_size(ctx, field, obj)
-		case "mode":
-			out.Values[i] = ec._RegularFile_mode(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("WalkConnection")
+		case "edges":
+			out.Values[i] = ec._WalkConnection_edges(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "modTime":
-			out.Values[i] = ec._RegularFile_modTime(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._WalkConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "parent":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._RegularFile_parent(ctx, field, obj)
-				wg.Done()
-			}(i, field)
-		case "contents":
-			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._RegularFile_contents(ctx, field, obj)
-				if out.Values[i] == graphql.Null {
-					invalid = true
-				}
-				wg.Done()
-			}(i, field)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	wg.Wait()
-	if invalid {
-		return graphql.Null
-	}
-	return out
-}
-
-// nolint: vetshadow
-func (ec *executionContext) _RegularFile_id(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalID(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) _RegularFile_name(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
-		Args:   nil,
-		Field:  field,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalString(res)
+	if invalid {
+		return graphql.Null
+	}
+	return out
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _RegularFile_path(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
+func (ec *executionContext) _WalkConnection_edges(ctx context.Context, field graphql.CollectedField, obj *WalkConnection) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
+		Object: "WalkConnection",
 		Args:   nil,
 		Field:  field,
 	}
@@ -2573,7 +8993,7 @@ func (ec *executionContext) _RegularFile_path(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.Edges, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2581,42 +9001,51 @@ func (ec *executionContext) _RegularFile_path(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]WalkEdge)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalString(res)
-}
 
-// nolint: vetshadow
-func (ec *executionContext) _RegularFile_size(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer ec.Tracer.EndFieldExecution(ctx)
-	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
-		Args:   nil,
-		Field:  field,
+	arr1 := make(graphql.Array, len(res))
+	var wg sync.WaitGroup
+
+	isLen1 := len(res) == 1
+	if !isLen1 {
+		wg.Add(len(res))
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Size(), nil
-	})
-	if resTmp == nil {
-		return graphql.Null
+
+	for idx1 := range res {
+		idx1 := idx1
+		rctx := &graphql.ResolverContext{
+			Index:  &idx1,
+			Result: &res[idx1],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(idx1 int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
+			arr1[idx1] = func() graphql.Marshaler {
+
+				return ec._WalkEdge(ctx, field.Selections, &res[idx1])
+			}()
+		}
+		if isLen1 {
+			f(idx1)
+		} else {
+			go f(idx1)
+		}
+
 	}
-	res := resTmp.(Int64)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return res
+	wg.Wait()
+	return arr1
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _RegularFile_mode(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
+func (ec *executionContext) _WalkConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *WalkConnection) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
+		Object: "WalkConnection",
 		Args:   nil,
 		Field:  field,
 	}
@@ -2624,7 +9053,7 @@ func (ec *executionContext) _RegularFile_mode(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mode(), nil
+		return obj.PageInfo, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2632,19 +9061,59 @@ func (ec *executionContext) _RegularFile_mode(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FileMode)
+	res := resTmp.(PageInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	return ec._FileMode(ctx, field.Selections, &res)
+	return ec._PageInfo(ctx, field.Selections, &res)
+}
+
+var walkEdgeImplementors = []string{"WalkEdge"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _WalkEdge(ctx context.Context, sel ast.SelectionSet, obj *WalkEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, walkEdgeImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WalkEdge")
+		case "cursor":
+			out.Values[i] = ec._WalkEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "node":
+			out.Values[i] = ec._WalkEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "depth":
+			out.Values[i] = ec._WalkEdge_depth(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _RegularFile_modTime(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
+func (ec *executionContext) _WalkEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *WalkEdge) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
+		Object: "WalkEdge",
 		Args:   nil,
 		Field:  field,
 	}
@@ -2652,7 +9121,7 @@ func (ec *executionContext) _RegularFile_modTime(ctx context.Context, field grap
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ModTime(), nil
+		return obj.Cursor, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2667,11 +9136,11 @@ func (ec *executionContext) _RegularFile_modTime(ctx context.Context, field grap
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _RegularFile_parent(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
+func (ec *executionContext) _WalkEdge_node(ctx context.Context, field graphql.CollectedField, obj *WalkEdge) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
 	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
+		Object: "WalkEdge",
 		Args:   nil,
 		Field:  field,
 	}
@@ -2679,9 +9148,12 @@ func (ec *executionContext) _RegularFile_parent(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.RegularFile().Parent(rctx, obj)
+		return obj.Node, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(File)
@@ -2692,25 +9164,19 @@ func (ec *executionContext) _RegularFile_parent(ctx context.Context, field graph
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _RegularFile_contents(ctx context.Context, field graphql.CollectedField, obj *RegularFile) graphql.Marshaler {
+func (ec *executionContext) _WalkEdge_depth(ctx context.Context, field graphql.CollectedField, obj *WalkEdge) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer ec.Tracer.EndFieldExecution(ctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_RegularFile_contents_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
-		Object: "RegularFile",
-		Args:   args,
+		Object: "WalkEdge",
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.RegularFile().Contents(rctx, obj, args["encoding"].(Encoding), args["maxReadBytes"].(Int64), args["seek"].(Int64))
+		return obj.Depth, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2718,11 +9184,10 @@ func (ec *executionContext) _RegularFile_contents(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(FileContents)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	return ec._FileContents(ctx, field.Selections, &res)
+	return graphql.MarshalInt(res)
 }
 
 var __DirectiveImplementors = []string{"__Directive"}
@@ -4174,10 +10639,63 @@ func (ec *executionContext) _File(ctx context.Context, sel ast.SelectionSet, obj
 		return ec._Dir(ctx, sel, &obj)
 	case *Dir:
 		return ec._Dir(ctx, sel, obj)
-	case Internal_OtherFile:
-		return ec._Internal_OtherFile(ctx, sel, &obj)
-	case *Internal_OtherFile:
-		return ec._Internal_OtherFile(ctx, sel, obj)
+	case Symlink:
+		return ec._Symlink(ctx, sel, &obj)
+	case *Symlink:
+		return ec._Symlink(ctx, sel, obj)
+	case NamedPipe:
+		return ec._NamedPipe(ctx, sel, &obj)
+	case *NamedPipe:
+		return ec._NamedPipe(ctx, sel, obj)
+	case Socket:
+		return ec._Socket(ctx, sel, &obj)
+	case *Socket:
+		return ec._Socket(ctx, sel, obj)
+	case Device:
+		return ec._Device(ctx, sel, &obj)
+	case *Device:
+		return ec._Device(ctx, sel, obj)
+	case IrregularFile:
+		return ec._IrregularFile(ctx, sel, &obj)
+	case *IrregularFile:
+		return ec._IrregularFile(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj *Node) graphql.Marshaler {
+	switch obj := (*obj).(type) {
+	case nil:
+		return graphql.Null
+	case RegularFile:
+		return ec._RegularFile(ctx, sel, &obj)
+	case *RegularFile:
+		return ec._RegularFile(ctx, sel, obj)
+	case Dir:
+		return ec._Dir(ctx, sel, &obj)
+	case *Dir:
+		return ec._Dir(ctx, sel, obj)
+	case Symlink:
+		return ec._Symlink(ctx, sel, &obj)
+	case *Symlink:
+		return ec._Symlink(ctx, sel, obj)
+	case NamedPipe:
+		return ec._NamedPipe(ctx, sel, &obj)
+	case *NamedPipe:
+		return ec._NamedPipe(ctx, sel, obj)
+	case Socket:
+		return ec._Socket(ctx, sel, &obj)
+	case *Socket:
+		return ec._Socket(ctx, sel, obj)
+	case Device:
+		return ec._Device(ctx, sel, &obj)
+	case *Device:
+		return ec._Device(ctx, sel, obj)
+	case IrregularFile:
+		return ec._IrregularFile(ctx, sel, &obj)
+	case *IrregularFile:
+		return ec._IrregularFile(ctx, sel, obj)
 	default:
 		panic(fmt.Errorf("unexpected type %T", obj))
 	}
@@ -4195,11 +10713,151 @@ func (ec *executionContext) _Result(ctx context.Context, sel ast.SelectionSet, o
 		return ec._FileResult(ctx, sel, &obj)
 	case *FileResult:
 		return ec._FileResult(ctx, sel, obj)
+	case CopyResult:
+		return ec._CopyResult(ctx, sel, &obj)
+	case *CopyResult:
+		return ec._CopyResult(ctx, sel, obj)
+	case RemoveAllResult:
+		return ec._RemoveAllResult(ctx, sel, &obj)
+	case *RemoveAllResult:
+		return ec._RemoveAllResult(ctx, sel, obj)
 	default:
 		panic(fmt.Errorf("unexpected type %T", obj))
 	}
 }
 
+func UnmarshalFileFilter(v interface{}) (FileFilter, error) {
+	var it FileFilter
+	var asMap = v.(map[string]interface{})
+	if _, present := asMap["includeHidden"]; !present {
+		asMap["includeHidden"] = true
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "glob":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Glob = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "types":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.Types = make([]FileType, len(rawIf1))
+			for idx1 := range rawIf1 {
+				err = (&it.Types[idx1]).UnmarshalGQL(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
+		case "minSize":
+			var err error
+			var ptr1 Int64
+			if v != nil {
+				err = (&ptr1).UnmarshalGQL(v)
+				it.MinSize = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "maxSize":
+			var err error
+			var ptr1 Int64
+			if v != nil {
+				err = (&ptr1).UnmarshalGQL(v)
+				it.MaxSize = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "modifiedAfter":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.ModifiedAfter = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "modifiedBefore":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.ModifiedBefore = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "includeHidden":
+			var err error
+			it.IncludeHidden, err = graphql.UnmarshalBoolean(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalFileOrder(v interface{}) (FileOrder, error) {
+	var it FileOrder
+	var asMap = v.(map[string]interface{})
+	if _, present := asMap["field"]; !present {
+		asMap["field"] = "name"
+	}
+	if _, present := asMap["direction"]; !present {
+		asMap["direction"] = "asc"
+	}
+	if _, present := asMap["dirsFirst"]; !present {
+		asMap["dirsFirst"] = false
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "field":
+			var err error
+			err = (&it.Field).UnmarshalGQL(v)
+			if err != nil {
+				return it, err
+			}
+		case "direction":
+			var err error
+			err = (&it.Direction).UnmarshalGQL(v)
+			if err != nil {
+				return it, err
+			}
+		case "dirsFirst":
+			var err error
+			it.DirsFirst, err = graphql.UnmarshalBoolean(v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) (ret interface{}) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -4249,6 +10907,9 @@ var parsedSchema = gqlparser.MustLoadSchema(
 "a 64-bit integer"
 scalar Int64 @scalarInfo(baseType: "Int")
 
+"a file upload, using the GraphQL multipart request spec"
+scalar Upload
+
 enum FileType {
     regular
     dir
@@ -4270,6 +10931,13 @@ type FileMode {
     sticky: Boolean!
 }
 
+# IDs can be passed to Query.node to refetch the object.
+"an object with a globally unique ID"
+interface Node {
+    "the object's ID"
+    id: ID!
+}
+
 "a generic file"
 interface File {
     "file ID"
@@ -4284,6 +10952,9 @@ interface File {
     mode: FileMode!
     "file modification time"
     modTime: String!
+    # Derived from the size and modTime, for use with ifMatch.
+    "an opaque string which changes when the file changes"
+    version: String!
     "the parent directory of this file, or null if at the root directory"
     parent: File
 }
@@ -4295,6 +10966,35 @@ enum Encoding {
     base64
 }
 
+"a line of a file"
+type Line {
+    "the line number, starting at 1"
+    number: Int!
+    "the line's text, without the line ending"
+    text: String!
+}
+
+"lines of a file"
+type FileLines {
+    "the requested lines"
+    lines: [Line!]!
+    "the total number of lines in the file"
+    totalLines: Int!
+    # Lines over the limit are cut, and lines past the limit are not returned.
+    "set if the lines exceed the size limit (the same as for contents), so not all are returned"
+    warning: String
+}
+
+"a checksum algorithm"
+enum HashAlgorithm {
+    sha256
+    sha512
+    sha1
+    md5
+    "CRC-32 using the IEEE polynomial"
+    crc32
+}
+
 "the contents of a file"
 type FileContents {
     "the file's data contents"
@@ -4307,50 +11007,269 @@ type FileContents {
     warning: String
 }
 
-type RegularFile implements File {
+type RegularFile implements File & Node {
     id: ID!
     name: String!
     path: String!
     size: Int64
     mode: FileMode!
     modTime: String!
+    version: String!
     parent: File
     # maxReadBytes is the max bytes to return, default (-1) for unlimited.
     # The implementation may enforce a hard cap on the bytes read, requiring paging with next/seek.
     # Negative seek means don't seek.
     "the contents of this file"
     contents(encoding: Encoding! = auto, maxReadBytes: Int64! = -1, seek: Int64! = -1): FileContents!
-}
-
-type Dir implements File {
+    # The whole file is read on the server, there is no size cap.
+    "the checksum of this file's contents, as lowercase hex"
+    hash(algorithm: HashAlgorithm!): String!
+    # From the file's extension if known, otherwise sniffed from the first 512 bytes.
+    "the MIME type of this file, or null if it can't be read"
+    mimeType: String
+    # Uses the same rules as the auto encoding of contents, over the start of the file.
+    "whether this file looks like text rather than binary, or null if it can't be read"
+    isText: Boolean
+    # from is the first line number to return, starting at 1; count is the max lines, default (-1) for unlimited.
+    # If fromEnd is true, from is counted back from the end (1 is the last line) and the lines end there,
+    # such as lines(count: 100, fromEnd: true) for the last 100 lines. The lines are always in file order.
+    "lines of this file's contents"
+    lines(from: Int! = 1, count: Int! = -1, fromEnd: Boolean! = false): FileLines!
+    # Supports HTTP Range requests, so large files can be downloaded without base64 or paging.
+    "the URL to download this file's raw bytes, or null if the server doesn't serve them"
+    downloadURL: String
+}
+
+type Dir implements File & Node {
     id: ID!
     name: String!
     path: String!
     size: Int64
     mode: FileMode!
     modTime: String!
+    version: String!
     parent: File
     # first is max children to return, default (-1) for unlimited.
-    # The children are in no particular order.
+    # The children are in no particular order unless orderBy is specified.
+    # The filter and order are applied before first.
     "this directory's nested (child) files"
-    children(first: Int! = -1): [File!]!
+    children(first: Int! = -1, orderBy: FileOrder, filter: FileFilter): [File!]!
+    # The children are ordered by name, cursors remain valid as files are added or removed.
+    # Use first/after for forward paging, or last/before for backward paging.
+    "this directory's nested (child) files, as a paged connection"
+    childrenConnection(first: Int, after: String, last: Int, before: String): FileConnection!
     # escaping this parent dir is not allowed.
+    # If followSymlinks is false and the file is a symlink, the Symlink itself is returned.
     "returns the specified nested file, or null if it doesn't exist"
-    file(path: String!): File
+    file(path: String!, followSymlinks: Boolean! = true): File
+    # maxDepth is the max depth to descend, default (-1) for unlimited; 1 only includes the children.
+    # Files are walked depth-first in lexical order, symlinks are not followed.
+    # The filter does not prevent walking into dirs, except for hidden dirs if includeHidden is false.
+    "all files nested in this dir's tree, as a paged connection"
+    walk(maxDepth: Int! = -1, filter: FileFilter, first: Int, after: String): WalkConnection!
+}
+
+"a symbolic link"
+type Symlink implements File & Node {
+    id: ID!
+    name: String!
+    path: String!
+    size: Int64
+    mode: FileMode!
+    modTime: String!
+    version: String!
+    parent: File
+    # target is the raw link text, it may be relative to the link's dir.
+    "the target of this link, or null if it can't be read"
+    target: String
+    # relative targets are resolved from the link's dir, absolute targets from the root dir.
+    "the file this link resolves to, or null if it doesn't exist"
+    resolved: File
+}
+
+"a named pipe (FIFO)"
+type NamedPipe implements File & Node {
+    id: ID!
+    name: String!
+    path: String!
+    size: Int64
+    mode: FileMode!
+    modTime: String!
+    version: String!
+    parent: File
+}
+
+"a unix domain socket"
+type Socket implements File & Node {
+    id: ID!
+    name: String!
+    path: String!
+    size: Int64
+    mode: FileMode!
+    modTime: String!
+    version: String!
+    parent: File
+}
+
+# mode.type is device for block devices, or charDevice for character devices.
+"a block or character device"
+type Device implements File & Node {
+    id: ID!
+    name: String!
+    path: String!
+    size: Int64
+    mode: FileMode!
+    modTime: String!
+    version: String!
+    parent: File
+    "the device's major number, or null if not available"
+    major: Int
+    "the device's minor number, or null if not available"
+    minor: Int
 }
 
-# do not reference Internal_OtherFile; the other file types may be moved to new types.
-"do not reference this type or depend on it in any way"
-type Internal_OtherFile implements File {
+"a file of an unknown type"
+type IrregularFile implements File & Node {
     id: ID!
     name: String!
     path: String!
-    size: Int64!
+    size: Int64
     mode: FileMode!
     modTime: String!
+    version: String!
     parent: File
 }
 
+"a field to order files by"
+enum FileOrderField {
+    name
+    size
+    modTime
+    type
+}
+
+"the direction of an ordering"
+enum OrderDirection {
+    asc
+    desc
+}
+
+"specifies how to order files"
+input FileOrder {
+    "the field to order by"
+    field: FileOrderField! = name
+    "the direction to order in"
+    direction: OrderDirection! = asc
+    "whether to put dirs before any other files, regardless of direction"
+    dirsFirst: Boolean! = false
+}
+
+# Times are in the same format as File.modTime, or any RFC 3339 time.
+"specifies which files to include, all conditions must match"
+input FileFilter {
+    # A glob containing a slash is matched against the path relative to the dir being listed or walked,
+    # where ** matches any number of dirs, such as **/*.go
+    "only include files with a name matching this glob pattern, such as *.go"
+    glob: String
+    "only include files of these types"
+    types: [FileType!]
+    "only include files of at least this size, in bytes"
+    minSize: Int64
+    "only include files of at most this size, in bytes"
+    maxSize: Int64
+    "only include files modified at or after this time"
+    modifiedAfter: String
+    "only include files modified before this time"
+    modifiedBefore: String
+    "whether to include hidden files, those with names starting with a dot"
+    includeHidden: Boolean! = true
+}
+
+"information about a page of results"
+type PageInfo {
+    "whether there are more results after this page"
+    hasNextPage: Boolean!
+    "whether there are more results before this page"
+    hasPreviousPage: Boolean!
+    "the cursor of the first edge, or null if there are no edges"
+    startCursor: String
+    "the cursor of the last edge, or null if there are no edges"
+    endCursor: String
+}
+
+"a file in a connection"
+type FileEdge {
+    "the cursor for this file, to be used with after or before"
+    cursor: String!
+    "the file"
+    node: File!
+}
+
+"a paged list of files"
+type FileConnection {
+    "the files in this page"
+    edges: [FileEdge!]!
+    "information about this page"
+    pageInfo: PageInfo!
+    "the total number of files, in all pages"
+    totalCount: Int!
+}
+
+"a file found by walking a dir tree"
+type WalkEdge {
+    "the cursor for this file, to be used with after"
+    cursor: String!
+    "the file"
+    node: File!
+    "the depth of the file relative to the walked dir, its children are at depth 1"
+    depth: Int!
+}
+
+"a paged list of files found by walking a dir tree"
+type WalkConnection {
+    "the files in this page"
+    edges: [WalkEdge!]!
+    "information about this page"
+    pageInfo: PageInfo!
+}
+
+"a match of a grep pattern"
+type GrepMatch {
+    "the line number of the match, starting at 1"
+    line: Int!
+    "the byte offset of the match in the file"
+    offset: Int64!
+    "the byte offset of the match in text"
+    column: Int!
+    "the length of the match in text, in bytes"
+    length: Int!
+    # Invalid UTF-8 is replaced with U+FFFD, and a line over the size limit (as for lines) is cut.
+    "the line containing the match, without the line ending"
+    text: String!
+    "the lines before the matched line, up to contextLines"
+    before: [String!]!
+    "the lines after the matched line, up to contextLines"
+    after: [String!]!
+}
+
+"the matches of a grep pattern in a file"
+type GrepFile {
+    "the file which matched"
+    file: File!
+    "the matches in this file"
+    matches: [GrepMatch!]!
+}
+
+"the result of a grep"
+type GrepResult {
+    "the files with matches"
+    files: [GrepFile!]!
+    "the total number of matches"
+    matchCount: Int!
+    "whether the search stopped early due to maxMatches"
+    truncated: Boolean!
+}
+
 "a generic result of an operation"
 interface Result {
     "a string describing the operation"
@@ -4372,6 +11291,28 @@ type FileResult implements Result {
     file: File!
 }
 
+"the result of a copy operation"
+type CopyResult implements Result {
+    s: String!
+    warning: String
+    "the destination file"
+    file: File!
+    "the number of files copied, including symlinks but not dirs"
+    files: Int!
+    "the number of bytes copied"
+    bytes: Int64!
+}
+
+"the result of a removeAll operation"
+type RemoveAllResult implements Result {
+    s: String!
+    warning: String
+    "the paths removed, or which would be removed if dryRun"
+    paths: [String!]!
+    "the total size of the regular files in paths, in bytes"
+    bytes: Int64!
+}
+
 type Query {
     "get the root dir"
     root: Dir!
@@ -4379,9 +11320,24 @@ type Query {
     # If the dir doesn't exist, null is returned.
     "returns the specified dir, or null if it doesn't exist"
     cd(path: String!): Dir
-    # essentially a shortcut for root.file(path)
+    # essentially a shortcut for root.file(path, followSymlinks)
     "returns the specified nested file, or null if it doesn't exist"
-    file(path: String!): File
+    file(path: String!, followSymlinks: Boolean! = true): File
+    # IDs from a different scope (another server or root) return null.
+    "returns the object with the specified ID, or null if it doesn't exist"
+    node(id: ID!): Node
+    "returns the objects with the specified IDs, with null for any which don't exist"
+    nodes(ids: [ID!]!): [Node]!
+    # essentially a shortcut for cd(path).walk(...)
+    "walks the specified dir's tree, or returns null if the dir doesn't exist"
+    find(path: String! = "/", maxDepth: Int! = -1, filter: FileFilter, first: Int, after: String): WalkConnection
+    # Searches the regular files in the path's tree, skipping files which look like binary (see Encoding auto).
+    # The pattern is a literal string unless regex is true, in which case it uses Go's regexp syntax.
+    # include is a list of globs, as in FileFilter.glob; if specified, only files matching any of them are searched.
+    # maxMatches is the max matches to return, default (-1) for unlimited.
+    # contextLines is the number of lines to include before and after each matched line.
+    "searches file contents for a pattern"
+    grep(pattern: String!, regex: Boolean! = false, caseInsensitive: Boolean! = false, path: String! = "/", include: [String!], maxMatches: Int! = -1, contextLines: Int! = 0): GrepResult!
 }
 
 "specifies how a file is to be opened"
@@ -4397,18 +11353,112 @@ enum FileOpen {
 }
 
 type Mutation {
+    # The preconditions on remove, rename, chmod and write are checked before the operation:
+    # ifMatch (a File.version, or * for any existing file), ifUnmodifiedSince (a time, as for File.modTime)
+    # and ifNotExists, which for rename is of the new name. If one doesn't hold, the operation fails with a ConflictError.
     "remove the specified file; if a directory, it must be empty"
-    remove(path: String!): OKResult!
+    remove(path: String!, ifMatch: String, ifUnmodifiedSince: String): OKResult!
+    # If dryRun is true, nothing is removed.
+    "remove the specified file; if a directory, everything in it is also removed"
+    removeAll(path: String!, dryRun: Boolean! = false): RemoveAllResult!
     "rename a file"
-    rename(path: String!, newName: String!): FileResult!
+    rename(path: String!, newName: String!, ifMatch: String, ifUnmodifiedSince: String, ifNotExists: Boolean! = false): FileResult!
     "change a file's mode (permission bits)"
-    chmod(path: String!, mode: Int!): FileResult!
+    chmod(path: String!, mode: Int!, ifMatch: String, ifUnmodifiedSince: String): FileResult!
+    # If atomic is true, the data is written to a temp file in the same dir which then replaces the file,
+    # so readers never see a partial write; the file's mode is preserved.
     "write to the specified file"
-    write(path: String!, contents: String!, open: [FileOpen!]! = [create, truncate], encoding: Encoding! = utf8): FileResult!
+    write(path: String!, contents: String!, open: [FileOpen!]! = [create, truncate], encoding: Encoding! = utf8, atomic: Boolean! = false, ifMatch: String, ifUnmodifiedSince: String, ifNotExists: Boolean! = false): FileResult!
+    # destPath is the path of the copy, not a dir to copy into.
+    # Dirs require recursive, in which case the whole tree is copied.
+    # If overwrite is true, existing files are replaced and existing dirs are merged into.
+    # Symlinks are copied as symlinks, other special files are skipped with a warning.
+    "copy a file or dir tree"
+    copy(path: String!, destPath: String!, recursive: Boolean! = false, overwrite: Boolean! = false, preserveMode: Boolean! = true, preserveTimes: Boolean! = false): CopyResult!
+    # destPath is the new path, not a dir to move into.
+    # If overwrite is true, an existing file or empty dir at destPath is replaced.
+    # If the file can't be renamed directly, it is copied and then removed.
+    "move a file or dir tree anywhere under the root"
+    move(path: String!, destPath: String!, overwrite: Boolean! = false): FileResult!
+    # A relative target is relative to the link's dir, an absolute target is relative to the root dir.
+    # Targets outside the root dir are not allowed.
+    "create a symbolic link at path which points to target"
+    symlink(target: String!, path: String!): FileResult!
+    "create a hard link at newPath to the existing file"
+    link(existingPath: String!, newPath: String!): FileResult!
+    # Times are in the same format as File.modTime, or any RFC 3339 time.
+    # If accessTime is null, it is set to modTime.
+    "change a file's modification and access times"
+    chtimes(path: String!, modTime: String!, accessTime: String): FileResult!
+    # If the file doesn't exist it is created empty, unless createIfMissing is false.
+    "set a file's modification and access times to now"
+    touch(path: String!, createIfMissing: Boolean! = true): FileResult!
+    # The file must already exist; writing past the end extends the file.
+    "write to the specified file at a byte offset, overwriting any existing data there"
+    writeAt(path: String!, offset: Int64!, contents: String!, encoding: Encoding! = utf8): FileResult!
+    # If size is larger than the file, it is extended with zero bytes.
+    "change the size of the specified file"
+    truncate(path: String!, size: Int64!): FileResult!
+    # The file is streamed from the request to the file system, see UploadHandler.
+    "upload to the specified file"
+    upload(path: String!, file: Upload!, open: [FileOpen!]! = [create, truncate]): FileResult!
     "make a single dir"
     mkdir(path: String!): FileResult!
     "make entire dir path, attempts to create any missing dirs"
     mkdirAll(path: String!): FileResult!
 }
+
+enum FileEventType {
+    created
+    modified
+    removed
+    renamed
+}
+
+"a change to a watched file"
+type FileEvent {
+    type: FileEventType!
+    "the path of the changed file"
+    path: String!
+    "the previous path of a renamed file"
+    oldPath: String
+    "the file as of the event, null if removed"
+    file: File
+}
+
+enum FollowEventType {
+    data
+    truncated
+    rotated
+}
+
+"an event while following a file"
+type FollowEvent {
+    type: FollowEventType!
+    "the data appended to the file, empty unless the type is data"
+    data: String!
+    "the encoding of data"
+    encoding: Encoding!
+    "the file offset of data"
+    offset: Int64!
+    "the file offset after data"
+    next: Int64!
+    "set to a warning if there was data loss"
+    warning: String
+}
+
+type Subscription {
+    # Changes are watched with inotify if the file system is backed by the OS (see OsPather),
+    # otherwise it is polled, in which case renames are seen as removed and created.
+    # If recursive is false and path is a dir, only changes to its children are emitted.
+    "emits the changes to the file or dir at path"
+    watch(path: String! = "/", recursive: Boolean! = false): FileEvent!
+    # If fromEnd is false, the existing contents are emitted first.
+    # The file is polled; it's truncated if its size shrinks, after which it's followed from the start,
+    # and rotated if the path is a different file (not detectable on all file systems), which is then followed.
+    # With utf8 or auto encoding, data never ends in a partial UTF-8 sequence, it's held until the rest is written.
+    "emits the data appended to the file at path as it grows, like tail -f"
+    follow(path: String!, fromEnd: Boolean! = false, encoding: Encoding! = auto): FollowEvent!
+}
 `},
 )
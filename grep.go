@@ -0,0 +1,205 @@
+package fsgraph
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+func (fs FS) grep(ctx context.Context, pattern string, regex bool, caseInsensitive bool, dirPath string, include []string, maxMatches int, contextLines int) (GrepResult, error) {
+	expr := pattern
+	if !regex {
+		expr = regexp.QuoteMeta(pattern)
+	}
+	if caseInsensitive {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return GrepResult{}, errors.Wrap(err, "Invalid pattern")
+	}
+	for _, glob := range include {
+		if _, err := path.Match(glob, ""); err != nil {
+			return GrepResult{}, errors.Wrap(err, "Invalid glob")
+		}
+	}
+	if contextLines < 0 {
+		contextLines = 0
+	}
+
+	result := GrepResult{
+		Files: []GrepFile{},
+	}
+	err = afero.Walk(fs.Fs, dirPath, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return skipWalkError(dirPath, fpath, fi, err)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		fpath = filepath.ToSlash(fpath)
+		if len(include) > 0 {
+			rel := strings.TrimPrefix(strings.TrimPrefix(fpath, dirPath), "/")
+			if rel == "" {
+				rel = fi.Name()
+			}
+			included := false
+			for _, glob := range include {
+				if matchFileGlob(glob, rel) {
+					included = true
+					break
+				}
+			}
+			if !included {
+				return nil
+			}
+		}
+		limit := -1
+		if maxMatches >= 0 {
+			limit = maxMatches - result.MatchCount
+		}
+		matches, truncated, err := grepFile(fs, fpath, re, limit, contextLines)
+		if err != nil {
+			if os.IsPermission(err) {
+				return nil // Can't read it, so it can't match.
+			}
+			return err
+		}
+		if len(matches) > 0 {
+			fx, err := getFsFileFromInfo(fpath, fi, fs)
+			if err != nil {
+				return err
+			}
+			result.Files = append(result.Files, GrepFile{File: fx, Matches: matches})
+			result.MatchCount += len(matches)
+		}
+		if truncated {
+			result.Truncated = true
+			return errStopWalk
+		}
+		return nil
+	})
+	if err != nil && err != errStopWalk {
+		return GrepResult{}, err
+	}
+	return result, nil
+}
+
+// Returns the matches in the file, up to limit matches unless limit is negative.
+// truncated is true if there were more matches than the limit.
+// No matches are returned if the file looks like binary.
+func grepFile(fs FS, fpath string, re *regexp.Regexp, limit int, contextLines int) (matches []GrepMatch, truncated bool, err error) {
+	f, err := fs.Open(fpath)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReaderSize(f, textCheckSize)
+	head, err := r.Peek(textCheckSize)
+	if err != nil && err != io.EOF {
+		return nil, false, err
+	}
+	if looksBinaryData(head, len(head) == textCheckSize) {
+		return nil, false, nil
+	}
+
+	var before []string // The previous lines, up to contextLines.
+	var pending []int   // Indexes of matches still needing lines after.
+	var offset int64
+	var buf []byte
+	for lineNum := 1; ; lineNum++ {
+		var n int64
+		var rerr error
+		buf, n, _, rerr = readLine(r, maxLinesSize, buf)
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return nil, false, rerr
+		}
+		raw := string(buf)
+		text := strings.ToValidUTF8(raw, "\uFFFD")
+
+		npending := 0
+		for _, i := range pending {
+			matches[i].After = append(matches[i].After, text)
+			if len(matches[i].After) < contextLines {
+				pending[npending] = i
+				npending++
+			}
+		}
+		pending = pending[:npending]
+
+		if !truncated {
+			for _, loc := range re.FindAllStringIndex(text, -1) {
+				if limit >= 0 && len(matches) >= limit {
+					truncated = true
+					break
+				}
+				matches = append(matches, GrepMatch{
+					Line:   lineNum,
+					Offset: Int64(offset + int64(rawIndex(raw, text, loc[0]))),
+					Column: loc[0],
+					Length: loc[1] - loc[0],
+					Text:   text,
+					Before: append([]string{}, before...),
+					After:  []string{},
+				})
+				if contextLines > 0 {
+					pending = append(pending, len(matches)-1)
+				}
+			}
+		}
+		if truncated && len(pending) == 0 {
+			break
+		}
+
+		if contextLines > 0 {
+			if len(before) == contextLines {
+				before = before[1:]
+			}
+			before = append(before, text)
+		}
+		offset += n
+	}
+	return matches, truncated, nil
+}
+
+// Returns the index in raw of the index i in text, which is raw with invalid UTF-8 replaced.
+func rawIndex(raw, text string, i int) int {
+	if raw == text {
+		return i
+	}
+	ri := 0
+	for ti := 0; ti < i && ri < len(raw); {
+		r, size := utf8.DecodeRuneInString(raw[ri:])
+		if r != utf8.RuneError || size > 1 {
+			ri += size
+			ti += size
+			continue
+		}
+		// A run of invalid bytes is one replacement.
+		for ri < len(raw) {
+			r, size = utf8.DecodeRuneInString(raw[ri:])
+			if r != utf8.RuneError || size > 1 {
+				break
+			}
+			ri++
+		}
+		ti += utf8.RuneLen(utf8.RuneError)
+	}
+	return ri
+}
@@ -0,0 +1,272 @@
+package fsgraph
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// The most line text returned at once, like the contents limit.
+const maxLinesSize = 8 * 1024 * 1024
+
+// The size of the blocks read from the end of a file for lines from the end.
+const linesBlockSize = 64 * 1024
+
+const linesTooLarge = "Lines exceed the size limit, not all returned"
+
+// Reads a line from br, without the line ending, into line[:0], returning it and the bytes read.
+// A line longer than max bytes is cut to max, and cut is true. Returns io.EOF if there are no more lines.
+func readLine(br *bufio.Reader, max int, line []byte) (out []byte, n int64, cut bool, err error) {
+	line = line[:0]
+	for {
+		chunk, err := br.ReadSlice('\n')
+		n += int64(len(chunk))
+		if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+			return nil, n, false, err
+		}
+		if err == nil {
+			chunk = chunk[:len(chunk)-1]
+		}
+		if len(line)+len(chunk) > max {
+			chunk = chunk[:max-len(line)]
+			cut = true
+		}
+		line = append(line, chunk...)
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF && n == 0 {
+			return nil, 0, false, io.EOF
+		}
+		if !cut {
+			line = bytes.TrimSuffix(line, []byte{'\r'})
+		}
+		return line, n, cut, nil
+	}
+}
+
+// Calls fn for each line read from r, without the line ending, stopping if fn returns false.
+// Lines are cut like readLine. Returns the number of lines read.
+func readLines(r io.Reader, max int, fn func(num int, line string, cut bool) bool) (int, error) {
+	br := bufio.NewReader(r)
+	num := 0
+	var line []byte
+	for {
+		var cut bool
+		var err error
+		line, _, cut, err = readLine(br, max, line)
+		if err != nil {
+			if err == io.EOF {
+				return num, nil
+			}
+			return num, err
+		}
+		num++
+		if !fn(num, string(line), cut) {
+			return num, nil
+		}
+	}
+}
+
+// Calls fn for each line of f, from the last line back, with the offset of its start,
+// stopping if fn returns false. Lines are cut like readLines.
+func readLinesBackward(f io.ReaderAt, size int64, max int, fn func(line string, cut bool, start int64) bool) error {
+	buf := make([]byte, linesBlockSize)
+	end := size
+	if size > 0 {
+		// A final line ending doesn't start another line.
+		if _, err := f.ReadAt(buf[:1], size-1); err != nil && err != io.EOF {
+			return err
+		}
+		if buf[0] == '\n' {
+			end--
+		}
+	}
+	var parts [][]byte // Of the current line, from its end back.
+	partsLen := 0
+	cut := false
+	emit := func(start int64) bool {
+		line := make([]byte, 0, partsLen)
+		for i := len(parts) - 1; i >= 0; i-- {
+			line = append(line, parts[i]...)
+		}
+		lcut := cut || len(line) > max
+		if len(line) > max {
+			line = line[:max]
+		}
+		parts, partsLen, cut = parts[:0], 0, false
+		s := string(line)
+		if !lcut {
+			s = strings.TrimSuffix(s, "\r")
+		}
+		return fn(s, lcut, start)
+	}
+	for pos := end; pos > 0; {
+		n := int64(len(buf))
+		if n > pos {
+			n = pos
+		}
+		pos -= n
+		block := buf[:n]
+		if nread, err := f.ReadAt(block, pos); nread < len(block) {
+			if err == nil {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		for {
+			i := bytes.LastIndexByte(block, '\n')
+			if part := block[i+1:]; len(part) > 0 {
+				parts = append(parts, append([]byte(nil), part...))
+				partsLen += len(part)
+			}
+			// Only the start of the line is kept.
+			for len(parts) > 1 && partsLen-len(parts[0]) >= max {
+				partsLen -= len(parts[0])
+				parts = parts[1:]
+				cut = true
+			}
+			if i < 0 {
+				break
+			}
+			if !emit(pos + int64(i) + 1) {
+				return nil
+			}
+			block = block[:i]
+		}
+	}
+	if size > 0 {
+		emit(0)
+	}
+	return nil
+}
+
+// Returns the number of line endings in f before offset end.
+func countLineEndings(ctx context.Context, f io.ReaderAt, end int64) (int, error) {
+	buf := make([]byte, linesBlockSize)
+	count := 0
+	for pos := int64(0); pos < end; {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		n := int64(len(buf))
+		if n > end-pos {
+			n = end - pos
+		}
+		nread, err := f.ReadAt(buf[:n], pos)
+		count += bytes.Count(buf[:nread], []byte{'\n'})
+		if int64(nread) < n {
+			if err == nil {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		pos += n
+	}
+	return count, nil
+}
+
+// Returns count lines starting at line number from (the first line is 1), count -1 for all.
+// If fromEnd, from is counted back from the last line, and the lines end at from.
+func (rf RegularFile) getLines(ctx context.Context, from int, count int, fromEnd bool) (FileLines, error) {
+	if from < 1 {
+		return FileLines{}, errors.New("from must be at least 1")
+	}
+	f, err := rf.fs.Open(rf.Path)
+	if err != nil {
+		return FileLines{}, err
+	}
+	defer f.Close()
+	if fromEnd {
+		return getLastLines(ctx, f, from-1, count)
+	}
+
+	fl := FileLines{
+		Lines: []Line{},
+	}
+	size := 0
+	fl.TotalLines, err = readLines(f, maxLinesSize, func(num int, line string, cut bool) bool {
+		if num >= from && (count < 0 || num < from+count) && fl.Warning == nil {
+			size += len(line)
+			if size > maxLinesSize {
+				warning := linesTooLarge
+				fl.Warning = &warning
+			} else {
+				fl.Lines = append(fl.Lines, Line{Number: num, Text: strings.ToValidUTF8(line, "\uFFFD")})
+				if cut {
+					warning := linesTooLarge
+					fl.Warning = &warning
+				}
+			}
+		}
+		return ctx.Err() == nil
+	})
+	if err != nil {
+		return FileLines{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		return FileLines{}, err
+	}
+	return fl, nil
+}
+
+// Returns count lines ending skip lines back from the last line, count -1 for all,
+// reading back from the end of the file, and then only counting the lines before them.
+func getLastLines(ctx context.Context, f afero.File, skip int, count int) (FileLines, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return FileLines{}, err
+	}
+	fl := FileLines{
+		Lines: []Line{},
+	}
+	var texts []string // From the last line back.
+	scanned := 0       // Lines from the end, at and after begin.
+	begin := fi.Size()
+	size := 0
+	err = readLinesBackward(f, fi.Size(), maxLinesSize, func(line string, cut bool, start int64) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		scanned++
+		begin = start
+		if scanned <= skip {
+			return true
+		}
+		if count >= 0 && len(texts) >= count {
+			return false
+		}
+		size += len(line)
+		if size > maxLinesSize {
+			warning := linesTooLarge
+			fl.Warning = &warning
+			return false
+		}
+		if cut {
+			warning := linesTooLarge
+			fl.Warning = &warning
+		}
+		texts = append(texts, line)
+		return true
+	})
+	if err != nil {
+		return FileLines{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		return FileLines{}, err
+	}
+	before, err := countLineEndings(ctx, f, begin)
+	if err != nil {
+		return FileLines{}, err
+	}
+	fl.TotalLines = before + scanned
+	for i := len(texts) - 1; i >= 0; i-- {
+		fl.Lines = append(fl.Lines, Line{Number: fl.TotalLines - skip - i, Text: strings.ToValidUTF8(texts[i], "\uFFFD")})
+	}
+	return fl, nil
+}
@@ -0,0 +1,230 @@
+package fsgraph
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// HardLinker is an optional interface for an afero.Fs which supports hard links.
+type HardLinker interface {
+	LinkIfPossible(oldname, newname string) error
+}
+
+// ErrNoHardLink is the error wrapped in an os.LinkError if the Fs doesn't support hard links.
+var ErrNoHardLink = errors.New("hard link not supported")
+
+// OsBasePathFs is a BasePathFs over the OS file system,
+// which also supports hard links, and keeps symlink targets relative to the base path.
+type OsBasePathFs struct {
+	*afero.BasePathFs
+}
+
+var _ afero.Symlinker = OsBasePathFs{}
+var _ HardLinker = OsBasePathFs{}
+var _ OsPather = OsBasePathFs{}
+
+func NewOsBasePathFs(basePath string) OsBasePathFs {
+	return OsBasePathFs{afero.NewBasePathFs(afero.NewOsFs(), basePath).(*afero.BasePathFs)}
+}
+
+// SymlinkIfPossible creates a symlink, an absolute oldname is relative to the base path.
+func (fs OsBasePathFs) SymlinkIfPossible(oldname, newname string) error {
+	newpath, err := fs.RealPath(newname)
+	if err != nil {
+		return &os.LinkError{Op: "symlink", Old: oldname, New: newname, Err: err}
+	}
+	target := filepath.FromSlash(oldname)
+	if filepath.IsAbs(target) {
+		target, err = fs.RealPath(oldname)
+		if err != nil {
+			return &os.LinkError{Op: "symlink", Old: oldname, New: newname, Err: err}
+		}
+	}
+	return os.Symlink(target, newpath)
+}
+
+// ReadlinkIfPossible reads a symlink, an absolute target within the base path is made relative to it.
+func (fs OsBasePathFs) ReadlinkIfPossible(name string) (string, error) {
+	target, err := fs.BasePathFs.ReadlinkIfPossible(name)
+	if err != nil || !filepath.IsAbs(target) {
+		return target, err
+	}
+	base, err := fs.RealPath("/")
+	if err != nil {
+		return target, nil
+	}
+	if rel, err := filepath.Rel(base, target); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return path.Clean("/" + filepath.ToSlash(rel)), nil
+	}
+	return target, nil
+}
+
+// LinkIfPossible creates a hard link.
+func (fs OsBasePathFs) LinkIfPossible(oldname, newname string) error {
+	oldpath, err := fs.RealPath(oldname)
+	if err != nil {
+		return &os.LinkError{Op: "link", Old: oldname, New: newname, Err: err}
+	}
+	newpath, err := fs.RealPath(newname)
+	if err != nil {
+		return &os.LinkError{Op: "link", Old: oldname, New: newname, Err: err}
+	}
+	return os.Link(oldpath, newpath)
+}
+
+// OsPath returns the OS path of name.
+func (fs OsBasePathFs) OsPath(name string) (string, error) {
+	return fs.RealPath(name)
+}
+
+// CopyOnWriteFs is an afero.CopyOnWriteFs which can create symlinks in dirs only in its base,
+// as it creates symlinks in its layer.
+type CopyOnWriteFs struct {
+	*afero.CopyOnWriteFs
+	base  afero.Fs
+	layer afero.Fs
+}
+
+var _ afero.Symlinker = CopyOnWriteFs{}
+
+func NewCopyOnWriteFs(base afero.Fs, layer afero.Fs) CopyOnWriteFs {
+	return CopyOnWriteFs{afero.NewCopyOnWriteFs(base, layer).(*afero.CopyOnWriteFs), base, layer}
+}
+
+// SymlinkIfPossible creates a symlink in the layer, first making its dir in the layer if it's only in the base.
+func (fs CopyOnWriteFs) SymlinkIfPossible(oldname, newname string) error {
+	if err := fs.copyUpDir(path.Dir(cleanPath(newname))); err != nil {
+		return &os.LinkError{Op: "symlink", Old: oldname, New: newname, Err: err}
+	}
+	return fs.CopyOnWriteFs.SymlinkIfPossible(oldname, newname)
+}
+
+// Makes dir and its parents in the layer with the modes they have in the base, if they're only in the base.
+func (fs CopyOnWriteFs) copyUpDir(dir string) error {
+	if _, err := fs.layer.Stat(dir); err == nil || dir == "/" {
+		return nil
+	}
+	if err := fs.copyUpDir(path.Dir(dir)); err != nil {
+		return err
+	}
+	fi, err := fs.base.Stat(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // Left for the symlink to fail.
+		}
+		return err
+	}
+	if err := fs.layer.Mkdir(dir, fi.Mode().Perm()); err != nil && !os.IsExist(err) {
+		return err
+	}
+	return nil
+}
+
+// The most symlinks followed resolving a path, like the OS limit.
+const maxSymlinks = 40
+
+// Returns fpath with the symlinks in it resolved, including the last element if follow.
+// What doesn't exist is left as is, returns an error if a target is outside the root.
+func (fs FS) resolveSymlinks(fpath string, follow bool) (string, error) {
+	return fs.resolveElems("/", strings.Split(cleanPath(fpath), "/"), follow, fpath)
+}
+
+// Returns where a symlink in dir, which is resolved, to target leads, with the symlinks resolved.
+// Returns an error if it's outside the root.
+func (fs FS) resolveTarget(dir, target string) (string, error) {
+	if path.IsAbs(target) {
+		dir = "/" // Absolute targets are relative to the root.
+	}
+	return fs.resolveElems(dir, strings.Split(target, "/"), true, target)
+}
+
+// Resolves the path elems from the resolved dir one at a time, like the OS does,
+// so a ".." after a symlink is from where the symlink leads, not from the symlink.
+// What doesn't exist is joined as is. name is used in the errors until a symlink is followed.
+func (fs FS) resolveElems(resolved string, elems []string, follow bool, name string) (string, error) {
+	lr, ok := fs.Fs.(afero.LinkReader)
+	missing := !ok // Nothing more to resolve, the elems are only joined.
+	for links := 0; len(elems) > 0; {
+		elem := elems[0]
+		elems = elems[1:]
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if resolved == "/" {
+				return "", errors.New("Symlink target is outside the root: " + name)
+			}
+			resolved = path.Dir(resolved)
+			continue
+		}
+		next := path.Join(resolved, elem)
+		if missing || len(elems) == 0 && !follow {
+			resolved = next
+			continue
+		}
+		fi, err := fs.Lstat(next)
+		if err != nil {
+			if os.IsNotExist(err) {
+				missing = true
+				resolved = next
+				continue
+			}
+			return "", err
+		}
+		if fi.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+		links++
+		if links > maxSymlinks {
+			return "", errors.New("Too many symlinks: " + name)
+		}
+		target, err := lr.ReadlinkIfPossible(next)
+		if err != nil {
+			return "", err
+		}
+		if path.IsAbs(target) {
+			resolved = "/"
+		}
+		elems = append(strings.Split(target, "/"), elems...)
+		name = next
+	}
+	return resolved, nil
+}
+
+func (fs FS) symlink(target, linkPath string) error {
+	linkPath = cleanPath(linkPath)
+	if target == "" {
+		return errors.New("Symlink target must not be empty")
+	}
+	// The dir is resolved, as a relative target is from where the link really is.
+	dir, err := fs.resolveSymlinks(path.Dir(linkPath), true)
+	if err != nil {
+		return err
+	}
+	if _, err := fs.resolveTarget(dir, target); err != nil {
+		return err
+	}
+	linker, ok := fs.Fs.(afero.Linker)
+	if !ok {
+		return &os.LinkError{Op: "symlink", Old: target, New: linkPath, Err: afero.ErrNoSymlink}
+	}
+	return linker.SymlinkIfPossible(target, linkPath)
+}
+
+func (fs FS) link(existingPath, newPath string) error {
+	existingPath = cleanPath(existingPath)
+	newPath = cleanPath(newPath)
+	if linker, ok := fs.Fs.(HardLinker); ok {
+		return linker.LinkIfPossible(existingPath, newPath)
+	}
+	if _, ok := fs.Fs.(*afero.OsFs); ok {
+		return os.Link(existingPath, newPath)
+	}
+	return &os.LinkError{Op: "link", Old: existingPath, New: newPath, Err: ErrNoHardLink}
+}
@@ -13,6 +13,13 @@ type File interface {
 	IsFile()
 }
 
+// a paged list of files
+type FileConnection struct {
+	Edges      []FileEdge `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
 // the contents of a file
 type FileContents struct {
 	Data     string   `json:"data"`
@@ -21,6 +28,30 @@ type FileContents struct {
 	Warning  *string  `json:"warning"`
 }
 
+// a file in a connection
+type FileEdge struct {
+	Cursor string `json:"cursor"`
+	Node   File   `json:"node"`
+}
+
+// specifies which files to include, all conditions must match
+type FileFilter struct {
+	Glob           *string    `json:"glob"`
+	Types          []FileType `json:"types"`
+	MinSize        *Int64     `json:"minSize"`
+	MaxSize        *Int64     `json:"maxSize"`
+	ModifiedAfter  *string    `json:"modifiedAfter"`
+	ModifiedBefore *string    `json:"modifiedBefore"`
+	IncludeHidden  bool       `json:"includeHidden"`
+}
+
+// lines of a file
+type FileLines struct {
+	Lines      []Line  `json:"lines"`
+	TotalLines int     `json:"totalLines"`
+	Warning    *string `json:"warning"`
+}
+
 // a representation of the file's mode
 type FileMode struct {
 	Type   FileType `json:"type"`
@@ -28,6 +59,58 @@ type FileMode struct {
 	Sticky bool     `json:"sticky"`
 }
 
+// specifies how to order files
+type FileOrder struct {
+	Field     FileOrderField `json:"field"`
+	Direction OrderDirection `json:"direction"`
+	DirsFirst bool           `json:"dirsFirst"`
+}
+
+// an event while following a file
+type FollowEvent struct {
+	Type     FollowEventType `json:"type"`
+	Data     string          `json:"data"`
+	Encoding Encoding        `json:"encoding"`
+	Offset   Int64           `json:"offset"`
+	Next     Int64           `json:"next"`
+	Warning  *string         `json:"warning"`
+}
+
+// the matches of a grep pattern in a file
+type GrepFile struct {
+	File    File        `json:"file"`
+	Matches []GrepMatch `json:"matches"`
+}
+
+// a match of a grep pattern
+type GrepMatch struct {
+	Line   int      `json:"line"`
+	Offset Int64    `json:"offset"`
+	Column int      `json:"column"`
+	Length int      `json:"length"`
+	Text   string   `json:"text"`
+	Before []string `json:"before"`
+	After  []string `json:"after"`
+}
+
+// the result of a grep
+type GrepResult struct {
+	Files      []GrepFile `json:"files"`
+	MatchCount int        `json:"matchCount"`
+	Truncated  bool       `json:"truncated"`
+}
+
+// a line of a file
+type Line struct {
+	Number int    `json:"number"`
+	Text   string `json:"text"`
+}
+
+// an object with a globally unique ID
+type Node interface {
+	IsNode()
+}
+
 type OKResult struct {
 	S       string  `json:"s"`
 	Warning *string `json:"warning"`
@@ -35,11 +118,42 @@ type OKResult struct {
 
 func (OKResult) IsResult() {}
 
+// information about a page of results
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+// the result of a removeAll operation
+type RemoveAllResult struct {
+	S       string   `json:"s"`
+	Warning *string  `json:"warning"`
+	Paths   []string `json:"paths"`
+	Bytes   Int64    `json:"bytes"`
+}
+
+func (RemoveAllResult) IsResult() {}
+
 // a generic result of an operation
 type Result interface {
 	IsResult()
 }
 
+// a paged list of files found by walking a dir tree
+type WalkConnection struct {
+	Edges    []WalkEdge `json:"edges"`
+	PageInfo PageInfo   `json:"pageInfo"`
+}
+
+// a file found by walking a dir tree
+type WalkEdge struct {
+	Cursor string `json:"cursor"`
+	Node   File   `json:"node"`
+	Depth  int    `json:"depth"`
+}
+
 // file contents (read) or write encoding
 type Encoding string
 
@@ -78,6 +192,44 @@ func (e Encoding) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type FileEventType string
+
+const (
+	FileEventTypeCreated  FileEventType = "created"
+	FileEventTypeModified FileEventType = "modified"
+	FileEventTypeRemoved  FileEventType = "removed"
+	FileEventTypeRenamed  FileEventType = "renamed"
+)
+
+func (e FileEventType) IsValid() bool {
+	switch e {
+	case FileEventTypeCreated, FileEventTypeModified, FileEventTypeRemoved, FileEventTypeRenamed:
+		return true
+	}
+	return false
+}
+
+func (e FileEventType) String() string {
+	return string(e)
+}
+
+func (e *FileEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FileEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FileEventType", str)
+	}
+	return nil
+}
+
+func (e FileEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // specifies how a file is to be opened
 type FileOpen string
 
@@ -121,6 +273,45 @@ func (e FileOpen) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// a field to order files by
+type FileOrderField string
+
+const (
+	FileOrderFieldName    FileOrderField = "name"
+	FileOrderFieldSize    FileOrderField = "size"
+	FileOrderFieldModTime FileOrderField = "modTime"
+	FileOrderFieldType    FileOrderField = "type"
+)
+
+func (e FileOrderField) IsValid() bool {
+	switch e {
+	case FileOrderFieldName, FileOrderFieldSize, FileOrderFieldModTime, FileOrderFieldType:
+		return true
+	}
+	return false
+}
+
+func (e FileOrderField) String() string {
+	return string(e)
+}
+
+func (e *FileOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FileOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FileOrderField", str)
+	}
+	return nil
+}
+
+func (e FileOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type FileType string
 
 const (
@@ -162,3 +353,118 @@ func (e *FileType) UnmarshalGQL(v interface{}) error {
 func (e FileType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type FollowEventType string
+
+const (
+	FollowEventTypeData      FollowEventType = "data"
+	FollowEventTypeTruncated FollowEventType = "truncated"
+	FollowEventTypeRotated   FollowEventType = "rotated"
+)
+
+func (e FollowEventType) IsValid() bool {
+	switch e {
+	case FollowEventTypeData, FollowEventTypeTruncated, FollowEventTypeRotated:
+		return true
+	}
+	return false
+}
+
+func (e FollowEventType) String() string {
+	return string(e)
+}
+
+func (e *FollowEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FollowEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FollowEventType", str)
+	}
+	return nil
+}
+
+func (e FollowEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// a checksum algorithm
+type HashAlgorithm string
+
+const (
+	HashAlgorithmSha256 HashAlgorithm = "sha256"
+	HashAlgorithmSha512 HashAlgorithm = "sha512"
+	HashAlgorithmSha1   HashAlgorithm = "sha1"
+	HashAlgorithmMd5    HashAlgorithm = "md5"
+	// CRC-32 using the IEEE polynomial
+	HashAlgorithmCrc32 HashAlgorithm = "crc32"
+)
+
+func (e HashAlgorithm) IsValid() bool {
+	switch e {
+	case HashAlgorithmSha256, HashAlgorithmSha512, HashAlgorithmSha1, HashAlgorithmMd5, HashAlgorithmCrc32:
+		return true
+	}
+	return false
+}
+
+func (e HashAlgorithm) String() string {
+	return string(e)
+}
+
+func (e *HashAlgorithm) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HashAlgorithm(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HashAlgorithm", str)
+	}
+	return nil
+}
+
+func (e HashAlgorithm) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// the direction of an ordering
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "asc"
+	OrderDirectionDesc OrderDirection = "desc"
+)
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
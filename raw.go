@@ -0,0 +1,68 @@
+package fsgraph
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// RawHandler serves the raw bytes of the regular file at the request's URL path,
+// use http.StripPrefix to serve it under a prefix such as /raw
+// Range requests and conditional requests are supported, the ETag is the file's version.
+// PUT streams the request body to the file, see rawUploadStatus for resumable uploads.
+func RawHandler(fs FS) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fs := fs.forContext(r.Context())
+		if serveRawUpload(fs, w, r) {
+			return
+		}
+		if r.Method != "GET" && r.Method != "HEAD" {
+			w.Header().Set("Allow", "GET, HEAD, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		fpath := cleanPath(r.URL.Path)
+		f, err := fs.Open(fpath)
+		if err != nil {
+			sendRawError(w, err)
+			return
+		}
+		defer f.Close()
+		fi, err := f.Stat()
+		if err != nil {
+			sendRawError(w, err)
+			return
+		}
+		if !fi.Mode().IsRegular() {
+			http.Error(w, "not a regular file", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", `"`+fileVersion(fi)+`"`)
+		http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+	})
+}
+
+func sendRawError(w http.ResponseWriter, err error) {
+	switch {
+	case IsConflict(err), os.IsExist(err):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case isRawRequestError(err):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case os.IsNotExist(err):
+		http.Error(w, "file not found", http.StatusNotFound)
+	case os.IsPermission(err):
+		http.Error(w, "permission denied", http.StatusForbidden)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// DownloadURL is the URL of this file's raw bytes, or nil if there's no RawURL.
+func (rf RegularFile) DownloadURL() *string {
+	if rf.fs.RawURL == "" {
+		return nil
+	}
+	u := strings.TrimSuffix(rf.fs.RawURL, "/") + (&url.URL{Path: cleanPath(rf.Path)}).EscapedPath()
+	return &u
+}
@@ -0,0 +1,287 @@
+package fsgraph
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// The resumable upload protocol of RawHandler, where each request is on the file's path:
+//
+//	POST ?uploads                          creates an upload session, returns its ID as upload
+//	GET ?upload=ID                         returns the session's offset, the bytes received so far
+//	PUT ?upload=ID&offset=N                appends the body at offset N, which must be the current offset
+//	POST ?upload=ID&hash=HEX&algorithm=A   finalizes the session if the checksum matches (default sha256)
+//	DELETE ?upload=ID                      aborts the session
+//
+// A session is a hidden file next to the destination, holding the bytes received so far,
+// so an interrupted upload can continue from its offset, even after a server restart.
+// Sessions which received nothing for uploadSessionExpiry are removed when another upload to the dir begins.
+// The responses are JSON rawUploadStatus.
+type rawUploadStatus struct {
+	Upload  string `json:"upload,omitempty"`
+	Offset  int64  `json:"offset"`
+	Version string `json:"version,omitempty"` // Of the finalized file.
+}
+
+// How long an upload session is kept without receiving anything, after which it's abandoned.
+var uploadSessionExpiry = 24 * time.Hour
+
+// Errors the client can fix, reported as 400 Bad Request.
+type rawRequestError string
+
+func (err rawRequestError) Error() string {
+	return string(err)
+}
+
+func isRawRequestError(err error) bool {
+	_, ok := err.(rawRequestError)
+	return ok
+}
+
+func sendRawStatus(w http.ResponseWriter, code int, status rawUploadStatus) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Upload-Offset", strconv.FormatInt(status.Offset, 10))
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(status)
+}
+
+func isUploadID(id string) bool {
+	_, err := hex.DecodeString(id)
+	return len(id) == 32 && err == nil
+}
+
+// Returns the session file path for the upload ID of fpath.
+func uploadSessionPath(fpath, id string) (string, error) {
+	if !isUploadID(id) {
+		return "", rawRequestError("Invalid upload ID")
+	}
+	return path.Join(path.Dir(fpath), "."+path.Base(fpath)+"."+id+".upload"), nil
+}
+
+// Returns the mode for a file replacing fpath, which is kept if fpath exists.
+func replacingPerm(fs FS, fpath string) (os.FileMode, error) {
+	fi, err := fs.Stat(fpath)
+	if err != nil {
+		return replacePerm, nil
+	}
+	if fi.IsDir() {
+		return 0, &os.PathError{Op: "rename", Path: fpath, Err: errors.New("is a directory")}
+	}
+	return fi.Mode().Perm(), nil
+}
+
+// Streams the body to a temp file which then replaces fpath, so a failed upload leaves fpath as is.
+func rawPut(fs FS, fpath string, body io.Reader) error {
+	perm, err := replacingPerm(fs, fpath)
+	if err != nil {
+		return err
+	}
+	return writeReplace(fs, fpath, perm, func(f afero.File) error {
+		_, err := io.Copy(f, body)
+		return err
+	})
+}
+
+// Reports whether the file name is of an upload session, from uploadSessionPath.
+func isUploadSessionName(name string) bool {
+	if !strings.HasPrefix(name, ".") || !strings.HasSuffix(name, ".upload") {
+		return false
+	}
+	name = strings.TrimSuffix(name, ".upload")
+	i := strings.LastIndexByte(name, '.')
+	return i > 0 && isUploadID(name[i+1:])
+}
+
+// Removes the upload sessions in dir which received nothing for uploadSessionExpiry, as abandoned.
+func removeExpiredUploads(fs FS, dir string) {
+	f, err := fs.Open(dir)
+	if err != nil {
+		return
+	}
+	infos, _ := f.Readdir(-1)
+	f.Close()
+	for _, fi := range infos {
+		if fi.Mode().IsRegular() && isUploadSessionName(fi.Name()) && time.Since(fi.ModTime()) > uploadSessionExpiry {
+			fs.Remove(path.Join(dir, fi.Name()))
+		}
+	}
+}
+
+func rawCreateUpload(fs FS, fpath string) (rawUploadStatus, error) {
+	if fi, err := fs.Stat(fpath); err == nil && fi.IsDir() {
+		return rawUploadStatus{}, &os.PathError{Op: "upload", Path: fpath, Err: errors.New("is a directory")}
+	}
+	var b [16]byte
+	_, err := rand.Read(b[:])
+	if err != nil {
+		return rawUploadStatus{}, err
+	}
+	id := hex.EncodeToString(b[:])
+	spath, _ := uploadSessionPath(fpath, id)
+	removeExpiredUploads(fs, path.Dir(fpath))
+	f, err := fs.OpenFile(spath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return rawUploadStatus{}, err
+	}
+	f.Close()
+	return rawUploadStatus{Upload: id}, nil
+}
+
+func rawUploadOffset(fs FS, spath string) (int64, error) {
+	fi, err := fs.Stat(spath)
+	if err != nil {
+		return 0, err
+	}
+	return fi.Size(), nil
+}
+
+// Appends the body to the session at offset, returning the new offset.
+// If offset isn't the current offset, the current offset is returned with a conflict error.
+// Chunks of a session are written one at a time, so two with the same offset can't both be appended.
+func rawPutChunk(fs FS, spath string, offset int64, body io.Reader) (int64, error) {
+	defer lockPaths(spath)()
+	f, err := fs.OpenFile(spath, os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if offset != fi.Size() {
+		return fi.Size(), &ConflictError{Path: spath, Reason: "upload offset is " + strconv.FormatInt(fi.Size(), 10)}
+	}
+	_, err = f.Seek(offset, io.SeekStart)
+	if err != nil {
+		return offset, err
+	}
+	n, err := io.Copy(f, body)
+	offset += n
+	if err != nil {
+		return offset, err
+	}
+	return offset, f.Close()
+}
+
+// Verifies the session's checksum and moves it to fpath.
+func rawFinalizeUpload(fs FS, fpath, spath string, algorithm HashAlgorithm, sum string) (rawUploadStatus, error) {
+	defer lockPaths(spath)()
+	if sum == "" {
+		return rawUploadStatus{}, rawRequestError("Checksum expected to finalize the upload")
+	}
+	h, err := newHash(algorithm)
+	if err != nil {
+		return rawUploadStatus{}, rawRequestError(err.Error())
+	}
+	f, err := fs.Open(spath)
+	if err != nil {
+		return rawUploadStatus{}, err
+	}
+	n, err := io.Copy(h, f)
+	f.Close()
+	if err != nil {
+		return rawUploadStatus{}, err
+	}
+	if hex.EncodeToString(h.Sum(nil)) != strings.ToLower(sum) {
+		return rawUploadStatus{Offset: n}, rawRequestError("Checksum mismatch")
+	}
+	perm, err := replacingPerm(fs, fpath)
+	if err != nil {
+		return rawUploadStatus{}, err
+	}
+	err = renameReplace(fs, spath, fpath, perm)
+	if err != nil {
+		return rawUploadStatus{}, err
+	}
+	fi, err := fs.Stat(fpath)
+	if err != nil {
+		return rawUploadStatus{}, err
+	}
+	return rawUploadStatus{Offset: n, Version: fileVersion(fi)}, nil
+}
+
+// Handles the resumable upload protocol and plain PUT, returns false if it's not an upload request.
+func serveRawUpload(fs FS, w http.ResponseWriter, r *http.Request) bool {
+	fpath := cleanPath(r.URL.Path)
+	query := r.URL.Query()
+	_, create := query["uploads"]
+	id := query.Get("upload")
+	if id == "" && !(create && r.Method == "POST") && r.Method != "PUT" {
+		return false
+	}
+	if fpath == "/" {
+		sendRawError(w, rawRequestError("Cannot upload to the root"))
+		return true
+	}
+	if id == "" {
+		if r.Method == "POST" {
+			status, err := rawCreateUpload(fs, fpath)
+			if err != nil {
+				sendRawError(w, err)
+				return true
+			}
+			sendRawStatus(w, http.StatusCreated, status)
+			return true
+		}
+		err := rawPut(fs, fpath, r.Body)
+		if err != nil {
+			sendRawError(w, err)
+			return true
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return true
+	}
+
+	spath, err := uploadSessionPath(fpath, id)
+	if err != nil {
+		sendRawError(w, err)
+		return true
+	}
+	status := rawUploadStatus{Upload: id}
+	switch r.Method {
+	case "GET", "HEAD":
+		status.Offset, err = rawUploadOffset(fs, spath)
+	case "PUT":
+		var offset int64
+		offset, err = strconv.ParseInt(query.Get("offset"), 10, 64)
+		if err != nil {
+			sendRawError(w, rawRequestError("Invalid offset"))
+			return true
+		}
+		status.Offset, err = rawPutChunk(fs, spath, offset, r.Body)
+		if IsConflict(err) {
+			sendRawStatus(w, http.StatusConflict, status)
+			return true
+		}
+	case "POST":
+		algorithm := HashAlgorithmSha256
+		if a := query.Get("algorithm"); a != "" {
+			algorithm = HashAlgorithm(a)
+		}
+		status, err = rawFinalizeUpload(fs, fpath, spath, algorithm, query.Get("hash"))
+	case "DELETE":
+		err = fs.Remove(spath)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT, POST, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return true
+	}
+	if err != nil {
+		sendRawError(w, err)
+		return true
+	}
+	sendRawStatus(w, http.StatusOK, status)
+	return true
+}
@@ -4,8 +4,10 @@ package fsgraph
 
 import (
 	context "context"
+	"io"
 	"os"
 	"path"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -14,6 +16,11 @@ type Resolver struct {
 	RootFS FS
 }
 
+// Returns the RootFS as seen by the request.
+func (r *Resolver) fs(ctx context.Context) FS {
+	return r.RootFS.forContext(ctx)
+}
+
 func (r *Resolver) Mutation() MutationResolver {
 	return &mutationResolver{r}
 }
@@ -21,10 +28,22 @@ func (r *Resolver) Query() QueryResolver {
 	return &queryResolver{r}
 }
 
+func (r *Resolver) Subscription() SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
 func (r *Resolver) FileResult() FileResultResolver {
 	return &fileResultResolver{r}
 }
 
+func (r *Resolver) CopyResult() CopyResultResolver {
+	return &copyResultResolver{r}
+}
+
+func (r *Resolver) FileEvent() FileEventResolver {
+	return &fileEventResolver{r}
+}
+
 func (r *Resolver) RegularFile() RegularFileResolver {
 	return &regularFileResolver{r}
 }
@@ -33,8 +52,24 @@ func (r *Resolver) Dir() DirResolver {
 	return &dirResolver{r}
 }
 
-func (r *Resolver) Internal_OtherFile() Internal_OtherFileResolver {
-	return &internal_OtherFileResolver{r}
+func (r *Resolver) Symlink() SymlinkResolver {
+	return &symlinkResolver{r}
+}
+
+func (r *Resolver) NamedPipe() NamedPipeResolver {
+	return &namedPipeResolver{r}
+}
+
+func (r *Resolver) Socket() SocketResolver {
+	return &socketResolver{r}
+}
+
+func (r *Resolver) Device() DeviceResolver {
+	return &deviceResolver{r}
+}
+
+func (r *Resolver) IrregularFile() IrregularFileResolver {
+	return &irregularFileResolver{r}
 }
 
 type fileResultResolver struct{ *Resolver }
@@ -43,7 +78,22 @@ func (r *fileResultResolver) File(ctx context.Context, obj *FileResult) (File, e
 	if obj.path == "" {
 		return nil, errors.New("not a file")
 	}
-	return r.RootFS.GetFile(obj.path)
+	return r.fs(ctx).GetFileNoFollow(obj.path)
+}
+
+type copyResultResolver struct{ *Resolver }
+
+func (r *copyResultResolver) File(ctx context.Context, obj *CopyResult) (File, error) {
+	if obj.path == "" {
+		return nil, errors.New("not a file")
+	}
+	return r.fs(ctx).GetFileNoFollow(obj.path)
+}
+
+type fileEventResolver struct{ *Resolver }
+
+func (r *fileEventResolver) File(ctx context.Context, obj *FileEvent) (File, error) {
+	return obj.file, nil
 }
 
 type regularFileResolver struct{ *Resolver }
@@ -56,34 +106,102 @@ func (r *regularFileResolver) Contents(ctx context.Context, obj *RegularFile, en
 	return obj.getContents(ctx, encoding, int64(maxReadBytes), int64(seek))
 }
 
+func (r *regularFileResolver) Hash(ctx context.Context, obj *RegularFile, algorithm HashAlgorithm) (string, error) {
+	return obj.getHash(ctx, algorithm)
+}
+
+func (r *regularFileResolver) MimeType(ctx context.Context, obj *RegularFile) (*string, error) {
+	return obj.getMimeType()
+}
+
+func (r *regularFileResolver) IsText(ctx context.Context, obj *RegularFile) (*bool, error) {
+	return obj.getIsText()
+}
+
+func (r *regularFileResolver) Lines(ctx context.Context, obj *RegularFile, from int, count int, fromEnd bool) (FileLines, error) {
+	return obj.getLines(ctx, from, count, fromEnd)
+}
+
 type dirResolver struct{ *Resolver }
 
 func (r *dirResolver) Parent(ctx context.Context, obj *Dir) (File, error) {
 	return obj.getParent()
 }
 
-func (r *dirResolver) Children(ctx context.Context, obj *Dir, first int) ([]File, error) {
-	return obj.getChildren(ctx, first)
+func (r *dirResolver) Children(ctx context.Context, obj *Dir, first int, orderBy *FileOrder, filter *FileFilter) ([]File, error) {
+	return obj.getChildren(ctx, first, orderBy, filter)
+}
+
+func (r *dirResolver) ChildrenConnection(ctx context.Context, obj *Dir, first *int, after *string, last *int, before *string) (FileConnection, error) {
+	return obj.getChildrenConnection(ctx, first, after, last, before)
 }
 
-func (r *dirResolver) File(ctx context.Context, obj *Dir, apath string) (File, error) {
-	f, err := r.RootFS.GetFile(path.Join(obj.Path, path.Clean(apath)))
+func (r *dirResolver) File(ctx context.Context, obj *Dir, apath string, followSymlinks bool) (File, error) {
+	fpath := path.Join(obj.Path, path.Clean(apath))
+	var f File
+	var err error
+	if followSymlinks {
+		f, err = r.fs(ctx).GetFile(fpath)
+	} else {
+		f, err = r.fs(ctx).GetFileNoFollow(fpath)
+	}
 	if err != nil && os.IsNotExist(err) {
 		return nil, nil
 	}
 	return f, err
 }
 
-type internal_OtherFileResolver struct{ *Resolver }
+func (r *dirResolver) Walk(ctx context.Context, obj *Dir, maxDepth int, filter *FileFilter, first *int, after *string) (WalkConnection, error) {
+	return obj.walk(ctx, maxDepth, filter, first, after)
+}
+
+type symlinkResolver struct{ *Resolver }
 
-func (r *internal_OtherFileResolver) Parent(ctx context.Context, obj *Internal_OtherFile) (File, error) {
+func (r *symlinkResolver) Parent(ctx context.Context, obj *Symlink) (File, error) {
+	return obj.getParent()
+}
+
+func (r *symlinkResolver) Target(ctx context.Context, obj *Symlink) (*string, error) {
+	return obj.getTarget()
+}
+
+func (r *symlinkResolver) Resolved(ctx context.Context, obj *Symlink) (File, error) {
+	return obj.getResolved()
+}
+
+type namedPipeResolver struct{ *Resolver }
+
+func (r *namedPipeResolver) Parent(ctx context.Context, obj *NamedPipe) (File, error) {
+	return obj.getParent()
+}
+
+type socketResolver struct{ *Resolver }
+
+func (r *socketResolver) Parent(ctx context.Context, obj *Socket) (File, error) {
+	return obj.getParent()
+}
+
+type deviceResolver struct{ *Resolver }
+
+func (r *deviceResolver) Parent(ctx context.Context, obj *Device) (File, error) {
+	return obj.getParent()
+}
+
+type irregularFileResolver struct{ *Resolver }
+
+func (r *irregularFileResolver) Parent(ctx context.Context, obj *IrregularFile) (File, error) {
 	return obj.getParent()
 }
 
 type mutationResolver struct{ *Resolver }
 
-func (r *mutationResolver) Remove(ctx context.Context, path string) (OKResult, error) {
-	err := r.RootFS.Remove(path)
+func (r *mutationResolver) Remove(ctx context.Context, path string, ifMatch *string, ifUnmodifiedSince *string) (OKResult, error) {
+	defer lockPaths(path)()
+	err := r.fs(ctx).checkPreconditions(path, ifMatch, ifUnmodifiedSince, false)
+	if err != nil {
+		return OKResult{}, err
+	}
+	err = r.fs(ctx).Remove(path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			warning := err.Error()
@@ -93,28 +211,98 @@ func (r *mutationResolver) Remove(ctx context.Context, path string) (OKResult, e
 	}
 	return OKResult{S: "removed"}, nil
 }
-func (r *mutationResolver) Rename(ctx context.Context, apath string, anewName string) (FileResult, error) {
-	err := r.RootFS.Rename(apath, anewName)
+func (r *mutationResolver) RemoveAll(ctx context.Context, path string, dryRun bool) (RemoveAllResult, error) {
+	return r.fs(ctx).removeAll(ctx, path, dryRun)
+}
+func (r *mutationResolver) Rename(ctx context.Context, apath string, anewName string, ifMatch *string, ifUnmodifiedSince *string, ifNotExists bool) (FileResult, error) {
+	newpath := path.Join(path.Dir(apath), anewName)
+	defer lockPaths(apath, newpath)()
+	err := r.fs(ctx).checkPreconditions(apath, ifMatch, ifUnmodifiedSince, false)
+	if err != nil {
+		return FileResult{}, err
+	}
+	err = r.fs(ctx).checkPreconditions(newpath, nil, nil, ifNotExists)
+	if err != nil {
+		return FileResult{}, err
+	}
+	err = r.fs(ctx).Rename(apath, newpath)
 	if err != nil {
 		return FileResult{}, err
 	}
-	newpath := path.Join(path.Dir(apath), anewName)
 	return FileResult{S: "renamed", path: newpath}, nil
 }
-func (r *mutationResolver) Chmod(ctx context.Context, path string, mode int) (FileResult, error) {
-	err := r.RootFS.Chmod(path, os.FileMode(mode)&os.ModePerm)
+func (r *mutationResolver) Chmod(ctx context.Context, path string, mode int, ifMatch *string, ifUnmodifiedSince *string) (FileResult, error) {
+	defer lockPaths(path)()
+	err := r.fs(ctx).checkPreconditions(path, ifMatch, ifUnmodifiedSince, false)
+	if err != nil {
+		return FileResult{}, err
+	}
+	err = r.fs(ctx).Chmod(path, os.FileMode(mode)&os.ModePerm)
 	if err != nil {
 		return FileResult{}, err
 	}
 	return FileResult{S: "mode changed", path: path}, nil
 }
-func (r *mutationResolver) Write(ctx context.Context, path string, contents string, open []FileOpen, encoding Encoding) (FileResult, error) {
+func (r *mutationResolver) Chtimes(ctx context.Context, path string, modTime string, accessTime *string) (FileResult, error) {
+	mtime, err := parseTime(modTime)
+	if err != nil {
+		return FileResult{}, err
+	}
+	atime := mtime
+	if accessTime != nil {
+		atime, err = parseTime(*accessTime)
+		if err != nil {
+			return FileResult{}, err
+		}
+	}
+	err = r.fs(ctx).Chtimes(path, atime, mtime)
+	if err != nil {
+		return FileResult{}, err
+	}
+	return FileResult{S: "times changed", path: path}, nil
+}
+func (r *mutationResolver) Touch(ctx context.Context, path string, createIfMissing bool) (FileResult, error) {
+	now := time.Now()
+	err := r.fs(ctx).Chtimes(path, now, now)
+	if err != nil {
+		if !createIfMissing || !os.IsNotExist(err) {
+			return FileResult{}, err
+		}
+		f, err := r.fs(ctx).OpenFile(path, os.O_WRONLY|os.O_CREATE, 0666)
+		if err != nil {
+			return FileResult{}, err
+		}
+		f.Close()
+		return FileResult{S: "file created", path: path}, nil
+	}
+	return FileResult{S: "times changed", path: path}, nil
+}
+func (r *mutationResolver) Write(ctx context.Context, path string, contents string, open []FileOpen, encoding Encoding, atomic bool, ifMatch *string, ifUnmodifiedSince *string, ifNotExists bool) (FileResult, error) {
+	defer lockPaths(path)()
+	err := r.fs(ctx).checkPreconditions(path, ifMatch, ifUnmodifiedSince, ifNotExists)
+	if err != nil {
+		return FileResult{}, err
+	}
+	old := "" // The version to change from.
+	if ifMatch != nil {
+		if fi, err := r.fs(ctx).Stat(path); err == nil {
+			old = fileVersion(fi)
+		}
+	}
 	openflags, err := fileOpenFlags(open)
 	if err != nil {
 		return FileResult{}, err
 	}
+	if atomic {
+		err = fileWriteAtomic(r.fs(ctx), path, openflags, contents, encoding)
+		if err != nil {
+			return FileResult{}, err
+		}
+		r.fs(ctx).newVersion(path, old)
+		return FileResult{S: "file written", path: path}, nil
+	}
 	openflags |= os.O_WRONLY
-	f, err := r.RootFS.OpenFile(path, openflags, 0666)
+	f, err := r.fs(ctx).OpenFile(path, openflags, 0666)
 	if err != nil {
 		return FileResult{}, err
 	}
@@ -123,17 +311,106 @@ func (r *mutationResolver) Write(ctx context.Context, path string, contents stri
 	if err != nil {
 		return FileResult{}, err
 	}
+	f.Close()
+	r.fs(ctx).newVersion(path, old)
 	return FileResult{S: "file written", path: path}, nil
 }
+func (r *mutationResolver) Copy(ctx context.Context, apath string, destPath string, recursive bool, overwrite bool, preserveMode bool, preserveTimes bool) (CopyResult, error) {
+	c := &copier{
+		ctx:           ctx,
+		fs:            r.fs(ctx),
+		overwrite:     overwrite,
+		preserveMode:  preserveMode,
+		preserveTimes: preserveTimes,
+	}
+	dst := cleanPath(destPath)
+	err := c.copy(cleanPath(apath), dst, recursive)
+	if err != nil {
+		return CopyResult{}, err
+	}
+	return CopyResult{S: "copied", Warning: c.warning(), Files: c.files, Bytes: Int64(c.bytes), path: dst}, nil
+}
+func (r *mutationResolver) Move(ctx context.Context, apath string, destPath string, overwrite bool) (FileResult, error) {
+	warning, err := r.fs(ctx).move(ctx, apath, destPath, overwrite)
+	if err != nil {
+		return FileResult{}, err
+	}
+	return FileResult{S: "moved", Warning: warning, path: cleanPath(destPath)}, nil
+}
+func (r *mutationResolver) Symlink(ctx context.Context, target string, path string) (FileResult, error) {
+	err := r.fs(ctx).symlink(target, path)
+	if err != nil {
+		return FileResult{}, err
+	}
+	return FileResult{S: "symlink created", path: path}, nil
+}
+func (r *mutationResolver) Link(ctx context.Context, existingPath string, newPath string) (FileResult, error) {
+	err := r.fs(ctx).link(existingPath, newPath)
+	if err != nil {
+		return FileResult{}, err
+	}
+	return FileResult{S: "link created", path: newPath}, nil
+}
+func (r *mutationResolver) WriteAt(ctx context.Context, path string, offset Int64, contents string, encoding Encoding) (FileResult, error) {
+	if offset < 0 {
+		return FileResult{}, errors.New("offset must not be negative")
+	}
+	f, err := r.fs(ctx).OpenFile(path, os.O_WRONLY, 0666)
+	if err != nil {
+		return FileResult{}, err
+	}
+	defer f.Close()
+	_, err = f.Seek(int64(offset), io.SeekStart)
+	if err != nil {
+		return FileResult{}, err
+	}
+	err = fileWrite(f, contents, encoding)
+	if err != nil {
+		return FileResult{}, err
+	}
+	return FileResult{S: "file written", path: path}, nil
+}
+func (r *mutationResolver) Truncate(ctx context.Context, path string, size Int64) (FileResult, error) {
+	if size < 0 {
+		return FileResult{}, errors.New("size must not be negative")
+	}
+	f, err := r.fs(ctx).OpenFile(path, os.O_WRONLY, 0666)
+	if err != nil {
+		return FileResult{}, err
+	}
+	defer f.Close()
+	err = f.Truncate(int64(size))
+	if err != nil {
+		return FileResult{}, err
+	}
+	return FileResult{S: "file truncated", path: path}, nil
+}
+func (r *mutationResolver) Upload(ctx context.Context, path string, file Upload, open []FileOpen) (FileResult, error) {
+	openflags, err := fileOpenFlags(open)
+	if err != nil {
+		return FileResult{}, err
+	}
+	openflags |= os.O_WRONLY
+	f, err := r.fs(ctx).OpenFile(path, openflags, 0666)
+	if err != nil {
+		return FileResult{}, err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, file.File)
+	if err != nil {
+		return FileResult{}, err
+	}
+	return FileResult{S: "file uploaded", path: path}, nil
+}
 func (r *mutationResolver) Mkdir(ctx context.Context, path string) (FileResult, error) {
-	err := r.RootFS.Mkdir(path, 0777)
+	err := r.fs(ctx).Mkdir(path, 0777)
 	if err != nil {
 		return FileResult{}, err
 	}
 	return FileResult{S: "directory created", path: path}, nil
 }
 func (r *mutationResolver) MkdirAll(ctx context.Context, path string) (FileResult, error) {
-	err := r.RootFS.MkdirAll(path, 0777)
+	err := r.fs(ctx).MkdirAll(path, 0777)
 	if err != nil {
 		return FileResult{}, err
 	}
@@ -143,10 +420,10 @@ func (r *mutationResolver) MkdirAll(ctx context.Context, path string) (FileResul
 type queryResolver struct{ *Resolver }
 
 func (r *queryResolver) Root(ctx context.Context) (Dir, error) {
-	return r.RootFS.GetDir("/")
+	return r.fs(ctx).GetDir("/")
 }
 func (r *queryResolver) Cd(ctx context.Context, path string) (*Dir, error) {
-	d, err := r.RootFS.GetDir(path)
+	d, err := r.fs(ctx).GetDir(path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -155,10 +432,48 @@ func (r *queryResolver) Cd(ctx context.Context, path string) (*Dir, error) {
 	}
 	return &d, nil
 }
-func (r *queryResolver) File(ctx context.Context, path string) (File, error) {
-	f, err := r.RootFS.GetFile(path)
+func (r *queryResolver) File(ctx context.Context, path string, followSymlinks bool) (File, error) {
+	var f File
+	var err error
+	if followSymlinks {
+		f, err = r.fs(ctx).GetFile(path)
+	} else {
+		f, err = r.fs(ctx).GetFileNoFollow(path)
+	}
 	if err != nil && os.IsNotExist(err) {
 		return nil, nil
 	}
 	return f, err
 }
+func (r *queryResolver) Node(ctx context.Context, id string) (Node, error) {
+	return r.fs(ctx).GetNode(id)
+}
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]Node, error) {
+	nodes := make([]Node, len(ids))
+	for i, id := range ids {
+		node, err := r.fs(ctx).GetNode(id)
+		if err == ErrInvalidID {
+			continue // Can't exist, so it's null like other missing nodes.
+		}
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+func (r *queryResolver) Find(ctx context.Context, path string, maxDepth int, filter *FileFilter, first *int, after *string) (*WalkConnection, error) {
+	return r.fs(ctx).find(ctx, path, maxDepth, filter, first, after)
+}
+func (r *queryResolver) Grep(ctx context.Context, pattern string, regex bool, caseInsensitive bool, path string, include []string, maxMatches int, contextLines int) (GrepResult, error) {
+	return r.fs(ctx).grep(ctx, pattern, regex, caseInsensitive, path, include, maxMatches, contextLines)
+}
+
+type subscriptionResolver struct{ *Resolver }
+
+func (r *subscriptionResolver) Watch(ctx context.Context, path string, recursive bool) (<-chan FileEvent, error) {
+	return r.fs(ctx).watch(ctx, path, recursive)
+}
+func (r *subscriptionResolver) Follow(ctx context.Context, path string, fromEnd bool, encoding Encoding) (<-chan FollowEvent, error) {
+	return r.fs(ctx).follow(ctx, path, fromEnd, encoding)
+}
@@ -32,3 +32,26 @@ func (x *Int64) UnmarshalGQL(v interface{}) error {
 func (x Int64) MarshalGQL(w io.Writer) {
 	fmt.Fprintf(w, `%d`, x)
 }
+
+// Upload is a file uploaded with a GraphQL multipart request, see UploadHandler.
+// File is read directly from the request body where possible, so it can only be read once.
+type Upload struct {
+	File io.Reader
+}
+
+func (x *Upload) UnmarshalGQL(v interface{}) error {
+	token, ok := v.(string)
+	if !ok {
+		return errors.New("Invalid type for Upload")
+	}
+	up, ok := lookupUpload(token)
+	if !ok {
+		return errors.New("Upload not found, expected a multipart request")
+	}
+	*x = *up
+	return nil
+}
+
+func (x Upload) MarshalGQL(w io.Writer) {
+	io.WriteString(w, `null`)
+}
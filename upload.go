@@ -0,0 +1,240 @@
+package fsgraph
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Uploads of in-flight multipart requests, by token.
+var uploads sync.Map
+
+func lookupUpload(token string) (*Upload, bool) {
+	up, ok := uploads.Load(token)
+	if !ok {
+		return nil, false
+	}
+	return up.(*Upload), true
+}
+
+func newUploadToken() (string, error) {
+	var b [16]byte
+	_, err := rand.Read(b[:])
+	if err != nil {
+		return "", err
+	}
+	return "upload:" + hex.EncodeToString(b[:]), nil
+}
+
+// UploadHandler wraps a GraphQL handler to support the GraphQL multipart request spec:
+// https://github.com/jaydenseric/graphql-multipart-request-spec
+// Other requests are passed through as is.
+// The file parts are not buffered in memory, they are read from the request body as
+// the resolvers read them; a part skipped over to get to a later one is spooled to a temp file.
+func UploadHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mediatype, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediatype != "multipart/form-data" || r.Method != "POST" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		parts := &multipartUploads{mr: multipart.NewReader(r.Body, params["boundary"])}
+		defer parts.close()
+		body, err := parts.readOperations()
+		if err != nil {
+			sendUploadError(w, err)
+			return
+		}
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.Header = make(http.Header, len(r.Header))
+		for k, v := range r.Header {
+			r2.Header[k] = v
+		}
+		r2.Header.Set("Content-Type", "application/json")
+		r2.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r2.ContentLength = int64(len(body))
+		next.ServeHTTP(w, r2)
+	})
+}
+
+func sendUploadError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]interface{}{{"message": err.Error()}},
+	})
+}
+
+// The file parts of a multipart request, which must be read in order from mr.
+type multipartUploads struct {
+	mu     sync.Mutex
+	mr     *multipart.Reader
+	cur    *multipart.Part
+	files  map[string]*uploadPart // By form name.
+	tokens []string
+}
+
+type uploadPart struct {
+	parts *multipartUploads
+	name  string
+	done  bool     // The part has been fully read from mr.
+	spool *os.File // The rest of the part, if it was skipped over.
+}
+
+// Reads the operations and map parts, which must come first,
+// and returns the operations with the upload tokens in place of the files.
+func (m *multipartUploads) readOperations() ([]byte, error) {
+	var ops map[string]interface{}
+	if err := m.readJSONPart("operations", &ops); err != nil {
+		return nil, err
+	}
+	var fileMap map[string][]string
+	if err := m.readJSONPart("map", &fileMap); err != nil {
+		return nil, err
+	}
+	m.files = make(map[string]*uploadPart, len(fileMap))
+	for name, opPaths := range fileMap {
+		token, err := newUploadToken()
+		if err != nil {
+			return nil, err
+		}
+		part := &uploadPart{parts: m, name: name}
+		m.files[name] = part
+		m.tokens = append(m.tokens, token)
+		uploads.Store(token, &Upload{File: part})
+		for _, opPath := range opPaths {
+			err := setOperationPath(ops, opPath, token)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return json.Marshal(ops)
+}
+
+func (m *multipartUploads) readJSONPart(name string, v interface{}) error {
+	part, err := m.mr.NextPart()
+	if err != nil {
+		return errors.Wrap(err, "Invalid multipart request")
+	}
+	defer part.Close()
+	if part.FormName() != name {
+		return errors.New("Invalid multipart request, expected " + name + " part")
+	}
+	err = json.NewDecoder(part).Decode(v)
+	if err != nil {
+		return errors.Wrap(err, "Invalid multipart request, "+name)
+	}
+	return nil
+}
+
+// Sets the value in ops at the dotted path, such as variables.files.0
+func setOperationPath(ops map[string]interface{}, opPath string, value interface{}) error {
+	elems := strings.Split(opPath, ".")
+	var cur interface{} = ops
+	for i, elem := range elems {
+		last := i == len(elems)-1
+		switch x := cur.(type) {
+		case map[string]interface{}:
+			if last {
+				x[elem] = value
+				return nil
+			}
+			cur = x[elem]
+		case []interface{}:
+			idx, err := strconv.Atoi(elem)
+			if err != nil || idx < 0 || idx >= len(x) {
+				return errors.New("Invalid multipart request, map path: " + opPath)
+			}
+			if last {
+				x[idx] = value
+				return nil
+			}
+			cur = x[idx]
+		default:
+			return errors.New("Invalid multipart request, map path: " + opPath)
+		}
+	}
+	return errors.New("Invalid multipart request, map path: " + opPath)
+}
+
+// Spools the rest of the current part if it's an upload, otherwise discards it.
+func (m *multipartUploads) skipCurrent() error {
+	defer m.cur.Close()
+	up := m.files[m.cur.FormName()]
+	if up == nil || up.done {
+		return nil
+	}
+	f, err := ioutil.TempFile("", "fsgraph-upload")
+	if err != nil {
+		return err
+	}
+	up.spool = f // Set now so close removes it.
+	_, err = io.Copy(f, m.cur)
+	if err != nil {
+		return err
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	return err
+}
+
+func (m *multipartUploads) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, token := range m.tokens {
+		uploads.Delete(token)
+	}
+	for _, up := range m.files {
+		if up.spool != nil {
+			up.spool.Close()
+			os.Remove(up.spool.Name())
+		}
+	}
+}
+
+func (up *uploadPart) Read(p []byte) (int, error) {
+	m := up.parts
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if up.spool != nil {
+		return up.spool.Read(p)
+	}
+	if up.done {
+		return 0, io.EOF
+	}
+	for m.cur == nil || m.cur.FormName() != up.name {
+		if m.cur != nil {
+			err := m.skipCurrent()
+			m.cur = nil
+			if err != nil {
+				return 0, err
+			}
+		}
+		part, err := m.mr.NextPart()
+		if err != nil {
+			if err == io.EOF {
+				err = errors.New("Upload missing from multipart request: " + up.name)
+			}
+			return 0, err
+		}
+		m.cur = part
+	}
+	n, err := m.cur.Read(p)
+	if err == io.EOF {
+		up.done = true
+	}
+	return n, err
+}
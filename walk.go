@@ -0,0 +1,142 @@
+package fsgraph
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// Returned from a walk func to stop walking without an error.
+var errStopWalk = errors.New("stop walk")
+
+// Handles an error passed to a walk func: a dir under root which can't be read is skipped,
+// like the files which can't be read, rather than ending the walk.
+func skipWalkError(root, fpath string, fi os.FileInfo, err error) error {
+	if os.IsPermission(err) && filepath.ToSlash(fpath) != root {
+		if fi != nil && fi.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	}
+	return err
+}
+
+// Compares slash separated paths in the order they are walked:
+// returns -1 if a is walked before b, 1 if after, or 0 if equal.
+func compareWalkOrder(a, b string) int {
+	ap := strings.Split(a, "/")
+	bp := strings.Split(b, "/")
+	for i := 0; i < len(ap) && i < len(bp); i++ {
+		if ap[i] != bp[i] {
+			if ap[i] < bp[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(ap) < len(bp):
+		return -1 // a is an ancestor of b
+	case len(ap) > len(bp):
+		return 1
+	}
+	return 0
+}
+
+// Walks the dir's tree; the cursors are paths relative to the dir,
+// so paging continues from the correct place even if files are added or removed.
+func (dir Dir) walk(ctx context.Context, maxDepth int, filter *FileFilter, first *int, after *string) (WalkConnection, error) {
+	if first != nil && *first < 0 {
+		return WalkConnection{}, errors.New("first must not be negative")
+	}
+	matcher, err := newFileMatcher(filter)
+	if err != nil {
+		return WalkConnection{}, err
+	}
+	var afterRel string
+	if after != nil {
+		afterRel, err = decodeCursor(*after)
+		if err != nil {
+			return WalkConnection{}, err
+		}
+	}
+
+	conn := WalkConnection{
+		Edges: []WalkEdge{},
+	}
+	conn.PageInfo.HasPreviousPage = after != nil
+	err = afero.Walk(dir.fs.Fs, dir.Path, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return skipWalkError(dir.Path, fpath, fi, err)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		fpath = filepath.ToSlash(fpath)
+		rel := strings.TrimPrefix(strings.TrimPrefix(fpath, dir.Path), "/")
+		if rel == "" {
+			return nil // The dir itself.
+		}
+		depth := strings.Count(rel, "/") + 1
+		if maxDepth >= 0 && depth > maxDepth {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		skipDir := fi.IsDir() && ((maxDepth >= 0 && depth >= maxDepth) || matcher.hides(fi))
+		if after != nil {
+			if cmp := compareWalkOrder(rel, afterRel); cmp <= 0 {
+				if skipDir || (cmp < 0 && fi.IsDir() && !strings.HasPrefix(afterRel, rel+"/")) {
+					return filepath.SkipDir // Entirely before the cursor.
+				}
+				return nil
+			}
+		}
+		if matcher.match(rel, fi) {
+			if first != nil && len(conn.Edges) >= *first {
+				conn.PageInfo.HasNextPage = true
+				return errStopWalk
+			}
+			fx, err := getFsFileFromInfo(fpath, fi, dir.fs)
+			if err != nil {
+				return err
+			}
+			conn.Edges = append(conn.Edges, WalkEdge{Cursor: encodeCursor(rel), Node: fx, Depth: depth})
+		}
+		if skipDir {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil && err != errStopWalk {
+		return WalkConnection{}, err
+	}
+	if len(conn.Edges) > 0 {
+		startCursor := conn.Edges[0].Cursor
+		endCursor := conn.Edges[len(conn.Edges)-1].Cursor
+		conn.PageInfo.StartCursor = &startCursor
+		conn.PageInfo.EndCursor = &endCursor
+	}
+	return conn, nil
+}
+
+// Walks the dir at the path, see Dir.walk
+func (fs FS) find(ctx context.Context, dirPath string, maxDepth int, filter *FileFilter, first *int, after *string) (*WalkConnection, error) {
+	dir, err := fs.GetDir(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	conn, err := dir.walk(ctx, maxDepth, filter, first, after)
+	if err != nil {
+		return nil, err
+	}
+	return &conn, nil
+}
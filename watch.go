@@ -0,0 +1,172 @@
+package fsgraph
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+type FileEvent struct {
+	Type    FileEventType `json:"type"`
+	Path    string        `json:"path"`
+	OldPath *string       `json:"oldPath"`
+	file    File
+}
+
+// OsPather is an optional interface for an afero.Fs backed by the OS file system,
+// which maps a path to its OS path, so changes can be watched with inotify.
+type OsPather interface {
+	OsPath(name string) (string, error)
+}
+
+// Returns the OS path of fpath, if the Fs is backed by the OS file system.
+func (fs FS) osPath(fpath string) (string, bool) {
+	switch x := fs.Fs.(type) {
+	case OsPather:
+		ospath, err := x.OsPath(fpath)
+		return ospath, err == nil
+	case *afero.OsFs:
+		return filepath.FromSlash(fpath), true
+	}
+	return "", false
+}
+
+// How often the files are checked for changes, if they can't be watched by the OS.
+var watchPollInterval = 2 * time.Second
+
+var errNoOSWatch = errors.New("OS watch not supported")
+
+type watcher struct {
+	ctx       context.Context
+	fs        FS
+	path      string
+	recursive bool
+	ch        chan FileEvent
+}
+
+// Returns the changes to the file or dir at fpath, the channel is closed when ctx is done.
+func (fs FS) watch(ctx context.Context, fpath string, recursive bool) (<-chan FileEvent, error) {
+	fpath = cleanPath(fpath)
+	if _, err := fs.Lstat(fpath); err != nil {
+		return nil, err
+	}
+	w := &watcher{
+		ctx:       ctx,
+		fs:        fs,
+		path:      fpath,
+		recursive: recursive,
+		ch:        make(chan FileEvent),
+	}
+	if _, ok := fs.osPath(fpath); !ok || w.watchOS() != nil {
+		// Also falls back if the OS can't watch it, such as running out of inotify watches.
+		go w.poll(w.snapshot())
+	}
+	return w.ch, nil
+}
+
+// Sends the event for fpath, returns false if the watch is done.
+func (w *watcher) emit(typ FileEventType, fpath string, oldPath *string) bool {
+	if afs, ok := w.fs.Fs.(*accessFs); ok {
+		if !afs.visible(fpath) {
+			return true // Omitted, like in dir listings.
+		}
+		if oldPath != nil && !afs.visible(*oldPath) {
+			// Moved in from a denied path, which isn't told.
+			typ, oldPath = FileEventTypeCreated, nil
+		}
+	}
+	ev := FileEvent{Type: typ, Path: fpath, OldPath: oldPath}
+	if typ != FileEventTypeRemoved {
+		if fi, err := w.fs.Lstat(fpath); err == nil {
+			ev.file, _ = getFsFileFromInfo(fpath, fi, w.fs)
+		}
+	}
+	select {
+	case w.ch <- ev:
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+type pollInfo struct {
+	size    int64
+	modTime time.Time
+	mode    os.FileMode
+}
+
+// Returns the info of the watched files by path.
+func (w *watcher) snapshot() map[string]pollInfo {
+	snap := make(map[string]pollInfo)
+	afero.Walk(w.fs.Fs, w.path, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil // Such as removed during the walk.
+		}
+		if err := w.ctx.Err(); err != nil {
+			return err
+		}
+		fpath = filepath.ToSlash(fpath)
+		snap[fpath] = pollInfo{fi.Size(), fi.ModTime(), fi.Mode()}
+		if fi.IsDir() && fpath != w.path && !w.recursive {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return snap
+}
+
+// Polls for changes since the prev snapshot.
+func (w *watcher) poll(prev map[string]pollInfo) {
+	defer close(w.ch)
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		cur := w.snapshot()
+		if w.ctx.Err() != nil {
+			return
+		}
+		paths := make([]string, 0, len(cur))
+		for fpath := range cur {
+			paths = append(paths, fpath)
+		}
+		for fpath := range prev {
+			if _, ok := cur[fpath]; !ok {
+				paths = append(paths, fpath)
+			}
+		}
+		sort.Strings(paths)
+		for _, fpath := range paths {
+			old, wasOK := prev[fpath]
+			info, ok := cur[fpath]
+			var typ FileEventType
+			switch {
+			case !wasOK:
+				typ = FileEventTypeCreated
+			case !ok:
+				typ = FileEventTypeRemoved
+			case info.mode != old.mode:
+				typ = FileEventTypeModified
+			case info.mode.IsDir():
+				continue // A dir's size and time change with its children.
+			case info.size != old.size || !info.modTime.Equal(old.modTime):
+				typ = FileEventTypeModified
+			default:
+				continue
+			}
+			if !w.emit(typ, fpath, nil) {
+				return
+			}
+		}
+		prev = cur
+	}
+}
@@ -0,0 +1,209 @@
+package fsgraph
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/spf13/afero"
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MODIFY | syscall.IN_ATTRIB |
+	syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO | syscall.IN_DELETE_SELF | syscall.IN_MOVE_SELF |
+	syscall.IN_DONT_FOLLOW
+
+type inotifyWatcher struct {
+	*watcher
+	f      *os.File
+	fd     int
+	rootWd int32
+	paths  map[int32]string // The FS paths by watch descriptor.
+}
+
+// Starts watching with inotify, returns an error if it can't.
+func (w *watcher) watchOS() error {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return os.NewSyscallError("inotify_init1", err)
+	}
+	iw := &inotifyWatcher{
+		watcher: w,
+		f:       os.NewFile(uintptr(fd), "inotify"), // Non-blocking, so Close interrupts Read.
+		fd:      fd,
+		paths:   make(map[int32]string),
+	}
+	iw.rootWd, err = iw.add(w.path)
+	if err == nil && w.recursive {
+		err = iw.addTree(w.path, false)
+	}
+	if err != nil {
+		iw.f.Close()
+		return err
+	}
+	go func() {
+		<-w.ctx.Done()
+		iw.f.Close()
+	}()
+	go iw.run()
+	return nil
+}
+
+func (iw *inotifyWatcher) add(fpath string) (int32, error) {
+	ospath, ok := iw.fs.osPath(fpath)
+	if !ok {
+		return -1, errNoOSWatch
+	}
+	wd, err := syscall.InotifyAddWatch(iw.fd, ospath, inotifyMask)
+	if err != nil {
+		return -1, os.NewSyscallError("inotify_add_watch", err)
+	}
+	iw.paths[int32(wd)] = fpath
+	return int32(wd), nil
+}
+
+// Watches the dirs under dir, such as a new or moved in dir.
+// If emit, created events are sent for what's in it, which may have been missed.
+func (iw *inotifyWatcher) addTree(dir string, emit bool) error {
+	return afero.Walk(iw.fs.Fs, dir, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil // Such as removed already.
+		}
+		fpath = filepath.ToSlash(fpath)
+		if fpath == dir {
+			return nil
+		}
+		if emit && !iw.emit(FileEventTypeCreated, fpath, nil) {
+			return iw.ctx.Err()
+		}
+		if fi.IsDir() {
+			if _, err := iw.add(fpath); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Stops watching the dirs at or under dir, such as when it's moved out.
+func (iw *inotifyWatcher) removeTree(dir string) {
+	for wd, fpath := range iw.paths {
+		if wd != iw.rootWd && isPathWithin(fpath, dir) {
+			syscall.InotifyRmWatch(iw.fd, uint32(wd))
+			delete(iw.paths, wd)
+		}
+	}
+}
+
+// Updates the watched dirs at or under oldDir to be under newDir.
+func (iw *inotifyWatcher) renameTree(oldDir, newDir string) {
+	for wd, fpath := range iw.paths {
+		if wd != iw.rootWd && isPathWithin(fpath, oldDir) {
+			iw.paths[wd] = newDir + strings.TrimPrefix(fpath, oldDir)
+		}
+	}
+}
+
+func (iw *inotifyWatcher) run() {
+	defer close(iw.ch)
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := iw.f.Read(buf)
+		if err != nil {
+			return
+		}
+		if !iw.handle(buf[:n]) {
+			return
+		}
+	}
+}
+
+// Handles the events read, returns false if the watch is done.
+func (iw *inotifyWatcher) handle(buf []byte) bool {
+	// A rename is a moved from event followed by a moved to event with the same cookie.
+	var movedFrom string
+	var movedCookie uint32
+	var movedDir bool
+	flushMoved := func() bool {
+		if movedFrom == "" {
+			return true
+		}
+		if movedDir {
+			iw.removeTree(movedFrom)
+		}
+		fpath := movedFrom
+		movedFrom = ""
+		return iw.emit(FileEventTypeRemoved, fpath, nil)
+	}
+
+	for off := 0; off+syscall.SizeofInotifyEvent <= len(buf); {
+		ev := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[off]))
+		nameStart := off + syscall.SizeofInotifyEvent
+		off = nameStart + int(ev.Len)
+		if off > len(buf) {
+			break
+		}
+		name := strings.TrimRight(string(buf[nameStart:off]), "\x00")
+		dir, ok := iw.paths[ev.Wd]
+		if !ok {
+			continue
+		}
+		if ev.Mask&syscall.IN_IGNORED != 0 {
+			delete(iw.paths, ev.Wd)
+			continue
+		}
+		fpath := dir
+		if name != "" {
+			fpath = path.Join(dir, name)
+		}
+		isDir := ev.Mask&syscall.IN_ISDIR != 0
+
+		if ev.Mask&syscall.IN_MOVED_TO != 0 && movedFrom != "" && ev.Cookie == movedCookie {
+			oldPath := movedFrom
+			movedFrom = ""
+			if isDir {
+				iw.renameTree(oldPath, fpath)
+			}
+			if !iw.emit(FileEventTypeRenamed, fpath, &oldPath) {
+				return false
+			}
+			continue
+		}
+		if !flushMoved() {
+			return false
+		}
+
+		switch {
+		case ev.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0:
+			if !iw.emit(FileEventTypeCreated, fpath, nil) {
+				return false
+			}
+			if isDir && iw.recursive {
+				if _, err := iw.add(fpath); err == nil {
+					iw.addTree(fpath, true)
+				}
+			}
+		case ev.Mask&syscall.IN_MOVED_FROM != 0:
+			movedFrom, movedCookie, movedDir = fpath, ev.Cookie, isDir
+		case ev.Mask&syscall.IN_DELETE != 0:
+			if !iw.emit(FileEventTypeRemoved, fpath, nil) {
+				return false
+			}
+		case ev.Mask&(syscall.IN_DELETE_SELF|syscall.IN_MOVE_SELF) != 0:
+			// Only reported for the root, others are reported by their parent dir.
+			if ev.Wd == iw.rootWd && !iw.emit(FileEventTypeRemoved, fpath, nil) {
+				return false
+			}
+		case ev.Mask&(syscall.IN_MODIFY|syscall.IN_ATTRIB) != 0:
+			if name == "" && ev.Wd != iw.rootWd {
+				continue // A watched subdir, reported by its parent dir.
+			}
+			if !iw.emit(FileEventTypeModified, fpath, nil) {
+				return false
+			}
+		}
+	}
+	return flushMoved()
+}
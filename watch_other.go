@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package fsgraph
+
+// Starts watching with the OS, returns an error if it can't.
+func (w *watcher) watchOS() error {
+	return errNoOSWatch
+}